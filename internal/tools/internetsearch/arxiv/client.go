@@ -0,0 +1,180 @@
+package arxiv
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ArxivAPIBaseURL is the base URL for the arXiv export API
+	ArxivAPIBaseURL = "https://export.arxiv.org/api"
+
+	// UserAgent for API requests
+	UserAgent = "mcp-devtools/1.0"
+
+	// arxivRequestInterval is the minimum gap between requests, arXiv asks API users to wait three seconds
+	arxivRequestInterval = 3 * time.Second
+
+	// arxivErrorIDPrefix identifies the entry arXiv returns in place of results for an invalid query
+	arxivErrorIDPrefix = "http://arxiv.org/api/errors"
+)
+
+// Sort orders accepted by the sortBy parameter
+const (
+	SortByRelevance     = "relevance"
+	SortBySubmittedDate = "submittedDate"
+)
+
+// ArxivSearchRequest holds the parameters for a search
+type ArxivSearchRequest struct {
+	SearchQuery string // search_query using arXiv field prefixes, e.g. "ti:transformer AND au:vaswani"
+	Start       int
+	MaxResults  int
+	SortBy      string
+}
+
+// ArxivClient handles HTTP requests to the arXiv export API
+type ArxivClient struct {
+	httpClient internetsearch.HTTPClientInterface
+	baseURL    string
+}
+
+// NewArxivClient creates a new arXiv API client limited to one request every arxivRequestInterval,
+// regardless of INTERNET_SEARCH_RATE_LIMIT
+func NewArxivClient() *ArxivClient {
+	return &ArxivClient{
+		baseURL:    ArxivAPIBaseURL,
+		httpClient: internetsearch.NewRateLimitedHTTPClientWithRate(1 / arxivRequestInterval.Seconds()),
+	}
+}
+
+// Search performs a search using the arXiv export API
+func (c *ArxivClient) Search(ctx context.Context, logger *logrus.Logger, request ArxivSearchRequest) (*ArxivFeed, error) {
+	reqURL, err := url.Parse(c.baseURL + "/query")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Check domain access security for API endpoint using security helper
+	if err := security.CheckDomainAccess(reqURL.Hostname()); err != nil {
+		if secErr, ok := err.(*security.SecurityError); ok {
+			return nil, security.FormatSecurityBlockError(secErr)
+		}
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("search_query", request.SearchQuery)
+	params.Set("start", strconv.Itoa(request.Start))
+	params.Set("max_results", strconv.Itoa(request.MaxResults))
+	if request.SortBy != "" {
+		params.Set("sortBy", request.SortBy)
+		params.Set("sortOrder", "descending")
+	}
+	reqURL.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/atom+xml")
+	req.Header.Set("User-Agent", UserAgent)
+
+	logger.WithFields(logrus.Fields{
+		"url":     reqURL.String(),
+		"sort_by": request.SortBy,
+	}).Debug("Making arXiv API request")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("Failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response body: %w", internetsearch.ErrNetwork, err))
+	}
+	internetsearch.CaptureResponse(ctx, logger, "arxiv", resp, body)
+
+	// Security analysis on content
+	if security.IsEnabled() {
+		sourceCtx := security.SourceContext{
+			URL:         reqURL.String(),
+			Domain:      reqURL.Hostname(),
+			ContentType: resp.Header.Get("Content-Type"),
+			Tool:        "internetsearch",
+		}
+
+		if secResult, err := security.AnalyseContent(string(body), sourceCtx); err == nil {
+			switch secResult.Action {
+			case security.ActionBlock:
+				return nil, security.FormatSecurityBlockErrorFromResult(secResult)
+			case security.ActionWarn:
+				logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
+			}
+		}
+	}
+
+	// Invalid queries come back as a feed holding an error entry, with either a 200 or a 400 status
+	var feed ArxivFeed
+	parseErr := xml.Unmarshal(body, &feed)
+	if parseErr == nil && len(feed.Entries) == 1 && strings.HasPrefix(feed.Entries[0].ID, arxivErrorIDPrefix) {
+		return nil, fmt.Errorf("invalid arXiv search query: %s", collapseSpace(feed.Entries[0].Summary))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.translateError(logger, resp, body)
+	}
+
+	if parseErr != nil {
+		return nil, fmt.Errorf("%w: failed to parse search response: %w", internetsearch.ErrParse, parseErr)
+	}
+
+	return &feed, nil
+}
+
+// translateError converts an arXiv error response into a classified error. arXiv answers bursts of
+// requests with 503 and a Retry-After header rather than 429.
+func (c *ArxivClient) translateError(logger *logrus.Logger, resp *http.Response, body []byte) error {
+	logger.WithFields(logrus.Fields{
+		"status_code": resp.StatusCode,
+		"status":      resp.Status,
+	}).Error("arXiv API request failed")
+
+	retryAfter := resp.Header.Get("Retry-After")
+	if resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode == http.StatusServiceUnavailable && retryAfter != "") {
+		return &internetsearch.RateLimitError{
+			Provider:   "arxiv",
+			RetryAfter: internetsearch.ParseRetryAfter(retryAfter),
+		}
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return internetsearch.Retryable(fmt.Errorf("arXiv API error: status %d", resp.StatusCode))
+	}
+	return fmt.Errorf("arXiv API request failed with status %d: %s", resp.StatusCode, string(body))
+}
+
+// collapseSpace joins the words of text with single spaces, arXiv wraps titles and abstracts over several lines
+func collapseSpace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
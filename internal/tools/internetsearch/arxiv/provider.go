@@ -0,0 +1,301 @@
+package arxiv
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// arXiv result limits, the API allows up to 2000 per request but asks for smaller slices
+	arxivMinResults = 1
+	arxivMaxResults = 100
+
+	// SearchTypePapers is the search type served by this provider
+	SearchTypePapers = "papers"
+
+	// arxivDateFormat is the timestamp format used in submittedDate ranges
+	arxivDateFormat = "200601021504"
+)
+
+// arxivFieldArgs maps the simple field arguments to arXiv search_query prefixes, in query order
+var arxivFieldArgs = []struct {
+	arg    string
+	prefix string
+}{
+	{arg: "arxiv_title", prefix: "ti"},
+	{arg: "arxiv_author", prefix: "au"},
+	{arg: "arxiv_abstract", prefix: "abs"},
+	{arg: "arxiv_category", prefix: "cat"},
+}
+
+// fieldPrefixPattern matches a query that already uses arXiv field prefixes, which is passed through unchanged
+var fieldPrefixPattern = regexp.MustCompile(`(?:^|[\s(])(?:ti|au|abs|co|jr|cat|rn|id|all|submittedDate):`)
+
+// ArxivProvider implements the unified SearchProvider interface
+type ArxivProvider struct {
+	client      *ArxivClient
+	retryPolicy internetsearch.RetryPolicy
+}
+
+// NewArxivProvider creates a new arXiv search provider
+func NewArxivProvider() *ArxivProvider {
+	return &ArxivProvider{
+		client:      NewArxivClient(),
+		retryPolicy: internetsearch.DefaultRetryPolicy(),
+	}
+}
+
+// GetName returns the provider name
+func (p *ArxivProvider) GetName() string {
+	return "arxiv"
+}
+
+// IsAvailable checks if the provider is available (always true as the API needs no key)
+func (p *ArxivProvider) IsAvailable() bool {
+	return p.client != nil
+}
+
+// GetSupportedTypes returns the search types this provider supports
+func (p *ArxivProvider) GetSupportedTypes() []string {
+	return []string{SearchTypePapers}
+}
+
+// Describe returns how the provider is configured, for provider listings
+func (p *ArxivProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary: "arXiv paper search via the export API, limited to one request every three seconds",
+	}
+}
+
+// Search executes a search using the arXiv provider. The query matches all fields unless it already
+// uses arXiv prefixes, and the arxiv_* arguments narrow it to titles, authors, abstracts or categories.
+func (p *ArxivProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	logger.WithFields(logrus.Fields{
+		"provider": "arxiv",
+		"type":     searchType,
+		"query":    query,
+	}).Debug("arXiv search parameters")
+
+	if searchType != SearchTypePapers {
+		return nil, fmt.Errorf("unsupported search type for arXiv: %s", searchType)
+	}
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := buildRequest(args, timeRange, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var feed *ArxivFeed
+	stats, err := internetsearch.Retry(ctx, logger, p.retryPolicy, func() error {
+		var searchErr error
+		feed, searchErr = p.client.Search(ctx, logger, request)
+		return searchErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("arxiv search failed: %w", err)
+	}
+
+	results := make([]internetsearch.SearchResult, 0, len(feed.Entries))
+	for _, entry := range feed.Entries {
+		if entry.ID == "" {
+			continue
+		}
+		result := convertEntry(entry)
+		result.Metadata["position"] = request.Start + len(results) + 1
+		results = append(results, result)
+	}
+
+	response := p.createSuccessResponse(query, results, feed.TotalResults, logger)
+	stats.Apply(response)
+	response.ApplyTimeRange(timeRange, true)
+	return response, nil
+}
+
+// buildRequest maps the search arguments to export API parameters
+func buildRequest(args map[string]any, timeRange string, now time.Time) (ArxivSearchRequest, error) {
+	request := ArxivSearchRequest{MaxResults: 10}
+
+	if countRaw, ok := args["count"].(float64); ok {
+		request.MaxResults = int(countRaw)
+		if request.MaxResults < arxivMinResults || request.MaxResults > arxivMaxResults {
+			return ArxivSearchRequest{}, fmt.Errorf("count must be between %d and %d for arXiv search, got %d", arxivMinResults, arxivMaxResults, request.MaxResults)
+		}
+	}
+
+	if startRaw, ok := args["start"].(float64); ok {
+		request.Start = int(startRaw)
+		if request.Start < 0 {
+			return ArxivSearchRequest{}, fmt.Errorf("start must not be negative for arXiv search, got %d", request.Start)
+		}
+	}
+
+	sort, err := internetsearch.ParseSort(args, internetsearch.SortRelevance)
+	if err != nil {
+		return ArxivSearchRequest{}, err
+	}
+	request.SortBy = SortByRelevance
+	if sort == internetsearch.SortDate {
+		request.SortBy = SortBySubmittedDate
+	}
+
+	request.SearchQuery = buildSearchQuery(args, timeRange, now)
+	if request.SearchQuery == "" {
+		return ArxivSearchRequest{}, fmt.Errorf("arXiv search needs a query or one of arxiv_title, arxiv_author, arxiv_abstract or arxiv_category")
+	}
+
+	return request, nil
+}
+
+// buildSearchQuery combines the query, field arguments and time range into a search_query, with every
+// term required to match
+func buildSearchQuery(args map[string]any, timeRange string, now time.Time) string {
+	var clauses []string
+
+	query, _ := args["query"].(string)
+	query = strings.TrimSpace(query)
+	rawQuery := fieldPrefixPattern.MatchString(query)
+	if rawQuery {
+		clauses = append(clauses, query)
+	} else {
+		for _, word := range strings.Fields(query) {
+			if word = sanitiseTerm(word); word != "" {
+				clauses = append(clauses, "all:"+word)
+			}
+		}
+	}
+
+	for _, field := range arxivFieldArgs {
+		value, _ := args[field.arg].(string)
+		if value = sanitiseTerm(value); value == "" {
+			continue
+		}
+		if strings.Contains(value, " ") {
+			value = `"` + value + `"`
+		}
+		clauses = append(clauses, field.prefix+":"+value)
+	}
+
+	if start := internetsearch.TimeRangeStart(timeRange, now); !start.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("submittedDate:[%s TO %s]", start.UTC().Format(arxivDateFormat), now.UTC().Format(arxivDateFormat)))
+	}
+
+	// A prefixed query may contain its own OR terms, so keep it grouped when other clauses follow
+	if rawQuery && len(clauses) > 1 {
+		clauses[0] = "(" + clauses[0] + ")"
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// sanitiseTerm removes the quotes and brackets that would unbalance a generated search_query
+func sanitiseTerm(term string) string {
+	term = strings.NewReplacer(`"`, "", "(", "", ")", "").Replace(term)
+	return collapseSpace(term)
+}
+
+// convertEntry maps a paper to a result linking to its abstract page
+func convertEntry(entry ArxivEntry) internetsearch.SearchResult {
+	absURL := entry.ID
+	metadata := map[string]any{
+		"arxiv_id": arxivID(entry.ID),
+	}
+
+	for _, link := range entry.Links {
+		switch {
+		case link.Rel == "alternate":
+			absURL = link.Href
+		case link.Title == "pdf":
+			metadata["pdf_url"] = secureURL(link.Href)
+		}
+	}
+
+	authors := make([]string, 0, len(entry.Authors))
+	for _, author := range entry.Authors {
+		if name := collapseSpace(author.Name); name != "" {
+			authors = append(authors, name)
+		}
+	}
+	metadata["authors"] = authors
+
+	var categories []string
+	for _, category := range entry.Categories {
+		if category.Term != "" {
+			categories = append(categories, category.Term)
+		}
+	}
+	if len(categories) > 0 {
+		metadata["categories"] = categories
+	}
+	if entry.PrimaryCategory.Term != "" {
+		metadata["primary_category"] = entry.PrimaryCategory.Term
+	}
+
+	if doi := strings.TrimSpace(entry.DOI); doi != "" {
+		metadata["doi"] = doi
+	}
+	if journalRef := collapseSpace(entry.JournalRef); journalRef != "" {
+		metadata["journal_ref"] = journalRef
+	}
+	if comment := collapseSpace(entry.Comment); comment != "" {
+		metadata["comment"] = comment
+	}
+	if entry.Published != "" {
+		metadata["published"] = entry.Published
+	}
+	if entry.Updated != "" && entry.Updated != entry.Published {
+		metadata["updated"] = entry.Updated
+	}
+
+	return internetsearch.SearchResult{
+		Title:       collapseSpace(entry.Title),
+		URL:         secureURL(absURL),
+		Description: collapseSpace(entry.Summary),
+		Metadata:    metadata,
+	}
+}
+
+// arxivID extracts the versioned identifier, such as 1706.03762v7, from an entry ID URL
+func arxivID(entryID string) string {
+	if _, id, ok := strings.Cut(entryID, "/abs/"); ok {
+		return id
+	}
+	return entryID
+}
+
+// secureURL upgrades the plain HTTP arxiv.org links the API returns
+func secureURL(link string) string {
+	if rest, ok := strings.CutPrefix(link, "http://arxiv.org/"); ok {
+		return "https://arxiv.org/" + rest
+	}
+	return link
+}
+
+// Helper functions
+func (p *ArxivProvider) createSuccessResponse(query string, results []internetsearch.SearchResult, totalResults int, logger *logrus.Logger) *internetsearch.SearchResponse {
+	result := &internetsearch.SearchResponse{
+		Results:   results,
+		Provider:  "arxiv",
+		Timestamp: time.Now(),
+	}
+	result.SetMetadata("total_results", totalResults)
+
+	logger.WithFields(logrus.Fields{
+		"query":        query,
+		"result_count": len(results),
+		"provider":     "arxiv",
+	}).Info("arXiv search completed successfully")
+
+	return result
+}
@@ -0,0 +1,243 @@
+package arxiv
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+func newTestProvider(server *httptest.Server) *ArxivProvider {
+	return &ArxivProvider{
+		client: &ArxivClient{
+			baseURL:    server.URL,
+			httpClient: server.Client(),
+		},
+		retryPolicy: internetsearch.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Second},
+	}
+}
+
+func TestArxivProvider_Results(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(testutils.FixtureHandler(t, "search.xml", "application/atom+xml", http.StatusOK, &received))
+	defer server.Close()
+
+	response, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "papers", map[string]any{
+		"query":        "attention",
+		"arxiv_author": "Vaswani",
+		"count":        float64(2),
+		"start":        float64(20),
+		"sort":         "date",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.URL.Path != "/query" {
+		t.Errorf("Expected the query endpoint, got %s", received.URL.Path)
+	}
+	query := received.URL.Query()
+	if query.Get("search_query") != "all:attention AND au:Vaswani" {
+		t.Errorf("Unexpected search_query: %q", query.Get("search_query"))
+	}
+	if query.Get("start") != "20" || query.Get("max_results") != "2" || query.Get("sortBy") != SortBySubmittedDate || query.Get("sortOrder") != "descending" {
+		t.Errorf("Unexpected paging or sort parameters: %v", query)
+	}
+
+	if response.Provider != "arxiv" || len(response.Results) != 2 || response.Metadata["total_results"] != 41234 {
+		t.Fatalf("Expected 2 arxiv results of 41234, got %d from %q with %v", len(response.Results), response.Provider, response.Metadata)
+	}
+
+	paper := response.Results[0]
+	if paper.Title != "Attention Is All You Need" || paper.URL != "https://arxiv.org/abs/1706.03762v7" {
+		t.Errorf("Expected a collapsed title and HTTPS abstract URL, got %q (%q)", paper.Title, paper.URL)
+	}
+	if !strings.HasPrefix(paper.Description, "The dominant sequence transduction models are based on complex recurrent or convolutional") {
+		t.Errorf("Expected the abstract as the description, got %q", paper.Description)
+	}
+	if authors, _ := paper.Metadata["authors"].([]string); !slices.Equal(authors, []string{"Ashish Vaswani", "Noam Shazeer", "Niki Parmar"}) {
+		t.Errorf("Expected all three authors in order, got %v", paper.Metadata["authors"])
+	}
+	if categories, _ := paper.Metadata["categories"].([]string); !slices.Equal(categories, []string{"cs.CL", "cs.LG"}) {
+		t.Errorf("Expected both categories, got %v", paper.Metadata["categories"])
+	}
+	if paper.Metadata["doi"] != "10.48550/arXiv.1706.03762" || paper.Metadata["pdf_url"] != "https://arxiv.org/pdf/1706.03762v7" {
+		t.Errorf("Unexpected DOI or PDF metadata: %v", paper.Metadata)
+	}
+	if paper.Metadata["arxiv_id"] != "1706.03762v7" || paper.Metadata["primary_category"] != "cs.CL" || paper.Metadata["position"] != 21 {
+		t.Errorf("Unexpected paper metadata: %v", paper.Metadata)
+	}
+	if paper.Metadata["published"] != "2017-06-12T17:57:34Z" || paper.Metadata["updated"] != "2023-08-02T00:41:18Z" {
+		t.Errorf("Expected published and updated dates, got %v and %v", paper.Metadata["published"], paper.Metadata["updated"])
+	}
+
+	preprint := response.Results[1]
+	if _, ok := preprint.Metadata["doi"]; ok {
+		t.Errorf("Expected no doi for a paper without one, got %v", preprint.Metadata["doi"])
+	}
+	if _, ok := preprint.Metadata["journal_ref"]; ok {
+		t.Errorf("Expected no journal_ref for a preprint, got %v", preprint.Metadata["journal_ref"])
+	}
+	if authors, _ := preprint.Metadata["authors"].([]string); !slices.Equal(authors, []string{"Jane Doe"}) {
+		t.Errorf("Expected a single author, got %v", preprint.Metadata["authors"])
+	}
+	if _, ok := preprint.Metadata["updated"]; ok {
+		t.Errorf("Expected no updated date when it matches published, got %v", preprint.Metadata["updated"])
+	}
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		args      map[string]any
+		timeRange string
+		want      string
+	}{
+		{
+			name: "free text matches all fields",
+			args: map[string]any{"query": "graph neural networks"},
+			want: "all:graph AND all:neural AND all:networks",
+		},
+		{
+			name: "field arguments",
+			args: map[string]any{"query": "", "arxiv_title": "attention is all you need", "arxiv_author": "vaswani", "arxiv_abstract": "transformer", "arxiv_category": "cs.CL"},
+			want: `ti:"attention is all you need" AND au:vaswani AND abs:transformer AND cat:cs.CL`,
+		},
+		{
+			name: "prefixed query passed through and grouped",
+			args: map[string]any{"query": "au:hinton OR au:lecun", "arxiv_category": "cs.LG"},
+			want: "(au:hinton OR au:lecun) AND cat:cs.LG",
+		},
+		{
+			name:      "time range",
+			args:      map[string]any{"query": "diffusion"},
+			timeRange: internetsearch.TimeRangeWeek,
+			want:      "all:diffusion AND submittedDate:[202402231200 TO 202403011200]",
+		},
+		{
+			name: "quotes and brackets removed",
+			args: map[string]any{"query": `"quantum" (error)`, "arxiv_title": `"surface codes"`},
+			want: `all:quantum AND all:error AND ti:"surface codes"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildSearchQuery(tt.args, tt.timeRange, now); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		args    map[string]any
+		want    ArxivSearchRequest
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			args: map[string]any{"query": "diffusion"},
+			want: ArxivSearchRequest{SearchQuery: "all:diffusion", MaxResults: 10, SortBy: SortByRelevance},
+		},
+		{
+			name: "date sort and paging",
+			args: map[string]any{"query": "diffusion", "sort": "date", "start": float64(50), "count": float64(25)},
+			want: ArxivSearchRequest{SearchQuery: "all:diffusion", Start: 50, MaxResults: 25, SortBy: SortBySubmittedDate},
+		},
+		{name: "empty query", args: map[string]any{"query": "  "}, wantErr: true},
+		{name: "negative start", args: map[string]any{"query": "diffusion", "start": float64(-1)}, wantErr: true},
+		{name: "count too large", args: map[string]any{"query": "diffusion", "count": float64(101)}, wantErr: true},
+		{name: "invalid sort", args: map[string]any{"query": "diffusion", "sort": "citations"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildRequest(tt.args, "", now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestArxivProvider_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(t *testing.T) http.HandlerFunc
+		check   func(error) bool
+	}{
+		{
+			name: "invalid query with bad request status",
+			handler: func(t *testing.T) http.HandlerFunc {
+				return testutils.FixtureHandler(t, "error.xml", "application/atom+xml", http.StatusBadRequest, nil)
+			},
+			check: func(err error) bool {
+				return strings.Contains(err.Error(), "invalid arXiv search query: max_results must be non-negative")
+			},
+		},
+		{
+			name: "invalid query with OK status",
+			handler: func(t *testing.T) http.HandlerFunc {
+				return testutils.FixtureHandler(t, "error.xml", "application/atom+xml", http.StatusOK, nil)
+			},
+			check: func(err error) bool { return strings.Contains(err.Error(), "invalid arXiv search query") },
+		},
+		{
+			name: "busy with retry-after",
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Retry-After", "30")
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			},
+			check: func(err error) bool {
+				var rateLimitErr *internetsearch.RateLimitError
+				return errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter == 30*time.Second
+			},
+		},
+		{
+			name: "malformed response",
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(`{"not": "xml"}`))
+				}
+			},
+			check: func(err error) bool { return errors.Is(err, internetsearch.ErrParse) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler(t))
+			defer server.Close()
+
+			_, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "papers", map[string]any{"query": "diffusion"})
+			if err == nil || !tt.check(err) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
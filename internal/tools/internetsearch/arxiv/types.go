@@ -0,0 +1,48 @@
+package arxiv
+
+import "encoding/xml"
+
+// ArxivFeed represents the Atom feed returned by the arXiv export API. Paging totals use the
+// OpenSearch namespace and paper details the arXiv namespace.
+type ArxivFeed struct {
+	XMLName      xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	TotalResults int          `xml:"http://a9.com/-/spec/opensearch/1.1/ totalResults"`
+	StartIndex   int          `xml:"http://a9.com/-/spec/opensearch/1.1/ startIndex"`
+	ItemsPerPage int          `xml:"http://a9.com/-/spec/opensearch/1.1/ itemsPerPage"`
+	Entries      []ArxivEntry `xml:"entry"`
+}
+
+// ArxivEntry represents a single paper in the feed. Invalid queries are reported as a single entry
+// whose ID points at the API's error documentation and whose summary holds the message.
+type ArxivEntry struct {
+	ID              string          `xml:"id"`
+	Title           string          `xml:"title"`
+	Summary         string          `xml:"summary"`
+	Published       string          `xml:"published"`
+	Updated         string          `xml:"updated"`
+	Authors         []ArxivAuthor   `xml:"author"`
+	Links           []ArxivLink     `xml:"link"`
+	Categories      []ArxivCategory `xml:"category"`
+	PrimaryCategory ArxivCategory   `xml:"http://arxiv.org/schemas/atom primary_category"`
+	DOI             string          `xml:"http://arxiv.org/schemas/atom doi"`
+	JournalRef      string          `xml:"http://arxiv.org/schemas/atom journal_ref"`
+	Comment         string          `xml:"http://arxiv.org/schemas/atom comment"`
+}
+
+// ArxivAuthor represents an author of a paper
+type ArxivAuthor struct {
+	Name string `xml:"name"`
+}
+
+// ArxivLink represents a link to the abstract page, PDF or DOI of a paper
+type ArxivLink struct {
+	Href  string `xml:"href,attr"`
+	Rel   string `xml:"rel,attr"`
+	Type  string `xml:"type,attr"`
+	Title string `xml:"title,attr"`
+}
+
+// ArxivCategory represents a subject category such as cs.CL
+type ArxivCategory struct {
+	Term string `xml:"term,attr"`
+}
@@ -124,18 +124,18 @@ func (c *BraveClient) makeRequest(ctx context.Context, logger *logrus.Logger, en
 				continue
 			}
 
-			return nil, fmt.Errorf("failed to make request after %d attempts: %w", maxRetries, err)
+			return nil, fmt.Errorf("%w: failed to make request after %d attempts: %w", internetsearch.ErrNetwork, maxRetries, err)
 		}
 
 		// Process successful response with security analysis
-		return c.processResponseWithSecurity(logger, resp, reqURL.String())
+		return c.processResponseWithSecurity(ctx, logger, resp, reqURL.String())
 	}
 
 	return nil, fmt.Errorf("unexpected end of retry loop")
 }
 
 // processResponseWithSecurity handles the HTTP response processing with security analysis
-func (c *BraveClient) processResponseWithSecurity(logger *logrus.Logger, resp *http.Response, requestURL string) ([]byte, error) {
+func (c *BraveClient) processResponseWithSecurity(ctx context.Context, logger *logrus.Logger, resp *http.Response, requestURL string) ([]byte, error) {
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
 			logger.WithError(closeErr).Warn("Failed to close response body")
@@ -162,6 +162,7 @@ func (c *BraveClient) processResponseWithSecurity(logger *logrus.Logger, resp *h
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
+	internetsearch.CaptureResponse(ctx, logger, "brave", resp, body)
 
 	// Security analysis on content
 	if security.IsEnabled() {
@@ -191,20 +192,29 @@ func (c *BraveClient) processResponseWithSecurity(logger *logrus.Logger, resp *h
 			"body":        string(body),
 		}).Error("Brave API request failed")
 
+		// Rate limiting is surfaced as a typed error so callers can honour Retry-After
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &internetsearch.RateLimitError{
+				Provider:   "brave",
+				RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			}
+		}
+
 		// Try to parse error response
 		var errorResp BraveErrorResponse
 		if err := json.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+			if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+				return nil, fmt.Errorf("%w: brave API error (%d): %s", internetsearch.ErrAuth, resp.StatusCode, errorResp.Message)
+			}
 			return nil, fmt.Errorf("brave API error (%d): %s", resp.StatusCode, errorResp.Message)
 		}
 
 		// Provide specific error messages for common status codes
 		switch resp.StatusCode {
 		case http.StatusUnauthorized:
-			return nil, fmt.Errorf("authentication failed: invalid API key")
+			return nil, fmt.Errorf("%w: invalid API key", internetsearch.ErrAuth)
 		case http.StatusForbidden:
-			return nil, fmt.Errorf("access forbidden: check your API key and subscription plan")
-		case http.StatusTooManyRequests:
-			return nil, fmt.Errorf("rate limit exceeded: please wait before making more requests")
+			return nil, internetsearch.Classify(internetsearch.ErrAuth, "access forbidden: check your API key and subscription plan")
 		case http.StatusInternalServerError:
 			return nil, fmt.Errorf("brave API internal server error: please try again later")
 		default:
@@ -220,17 +230,40 @@ func (c *BraveClient) processResponseWithSecurity(logger *logrus.Logger, resp *h
 	return body, nil
 }
 
+// SearchOptions holds optional parameters shared by the Brave search endpoints
+type SearchOptions struct {
+	Freshness  string                      // pd/pw/pm/py or a custom date range
+	Country    string                      // 2 character country code, or "ALL"
+	SafeSearch string                      // off, moderate or strict
+	Domains    internetsearch.DomainFilter // Applied as site: and -site: query operators
+}
+
+// apply adds the non-empty options to the request parameters
+func (o SearchOptions) apply(params map[string]string) {
+	if o.Freshness != "" {
+		params["freshness"] = o.Freshness
+	}
+	if o.Country != "" {
+		params["country"] = o.Country
+	}
+	if o.SafeSearch != "" {
+		params["safesearch"] = o.SafeSearch
+	}
+	// Brave has no domain parameters but supports site: operators in the query
+	if !o.Domains.IsEmpty() {
+		params["q"] = o.Domains.ExcludeOperators(o.Domains.IncludeOperators(params["q"]))
+	}
+}
+
 // InternetSearch performs an internet search using the Brave API
-func (c *BraveClient) InternetSearch(ctx context.Context, logger *logrus.Logger, query string, count int, offset int, freshness string) (*BraveInternetSearchResponse, error) {
+func (c *BraveClient) InternetSearch(ctx context.Context, logger *logrus.Logger, query string, count int, offset int, opts SearchOptions) (*BraveInternetSearchResponse, error) {
 	params := map[string]string{
 		"q":      query,
 		"count":  fmt.Sprintf("%d", count),
 		"offset": fmt.Sprintf("%d", offset),
 	}
 
-	if freshness != "" {
-		params["freshness"] = freshness
-	}
+	opts.apply(params)
 
 	body, err := c.makeRequest(ctx, logger, "/web/search", params)
 	if err != nil {
@@ -246,12 +279,19 @@ func (c *BraveClient) InternetSearch(ctx context.Context, logger *logrus.Logger,
 }
 
 // ImageSearch performs an image search using the Brave API
-func (c *BraveClient) ImageSearch(ctx context.Context, logger *logrus.Logger, query string, count int) (*BraveImageSearchResponse, error) {
+func (c *BraveClient) ImageSearch(ctx context.Context, logger *logrus.Logger, query string, count int, opts SearchOptions) (*BraveImageSearchResponse, error) {
 	params := map[string]string{
 		"q":     query,
 		"count": fmt.Sprintf("%d", count),
 	}
 
+	// The image endpoint has no freshness filter and only supports off or strict safe search
+	opts.Freshness = ""
+	if opts.SafeSearch == internetsearch.SafeSearchModerate {
+		opts.SafeSearch = ""
+	}
+	opts.apply(params)
+
 	body, err := c.makeRequest(ctx, logger, "/images/search", params)
 	if err != nil {
 		return nil, err
@@ -266,15 +306,13 @@ func (c *BraveClient) ImageSearch(ctx context.Context, logger *logrus.Logger, qu
 }
 
 // NewsSearch performs a news search using the Brave API
-func (c *BraveClient) NewsSearch(ctx context.Context, logger *logrus.Logger, query string, count int, freshness string) (*BraveNewsSearchResponse, error) {
+func (c *BraveClient) NewsSearch(ctx context.Context, logger *logrus.Logger, query string, count int, opts SearchOptions) (*BraveNewsSearchResponse, error) {
 	params := map[string]string{
 		"q":     query,
 		"count": fmt.Sprintf("%d", count),
 	}
 
-	if freshness != "" {
-		params["freshness"] = freshness
-	}
+	opts.apply(params)
 
 	body, err := c.makeRequest(ctx, logger, "/news/search", params)
 	if err != nil {
@@ -290,15 +328,13 @@ func (c *BraveClient) NewsSearch(ctx context.Context, logger *logrus.Logger, que
 }
 
 // VideoSearch performs a video search using the Brave API
-func (c *BraveClient) VideoSearch(ctx context.Context, logger *logrus.Logger, query string, count int, freshness string) (*BraveVideoSearchResponse, error) {
+func (c *BraveClient) VideoSearch(ctx context.Context, logger *logrus.Logger, query string, count int, opts SearchOptions) (*BraveVideoSearchResponse, error) {
 	params := map[string]string{
 		"q":     query,
 		"count": fmt.Sprintf("%d", count),
 	}
 
-	if freshness != "" {
-		params["freshness"] = freshness
-	}
+	opts.apply(params)
 
 	body, err := c.makeRequest(ctx, logger, "/videos/search", params)
 	if err != nil {
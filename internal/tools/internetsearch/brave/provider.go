@@ -10,6 +10,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// freshnessValues maps time ranges to Brave's freshness values
+var freshnessValues = map[string]string{
+	internetsearch.TimeRangeDay:   "pd",
+	internetsearch.TimeRangeWeek:  "pw",
+	internetsearch.TimeRangeMonth: "pm",
+	internetsearch.TimeRangeYear:  "py",
+}
+
 // BraveProvider implements the unified SearchProvider interface
 type BraveProvider struct {
 	client *BraveClient
@@ -42,6 +50,14 @@ func (p *BraveProvider) GetSupportedTypes() []string {
 	return []string{"web", "image", "news", "video", "local"}
 }
 
+// Describe returns how the provider is configured, for provider listings
+func (p *BraveProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "Brave Search API with web, image, news, video and local (Pro plan) search and freshness filtering",
+		RequiredEnvVars: []string{"BRAVE_API_KEY"},
+	}
+}
+
 // Search executes a search using the Brave provider
 func (p *BraveProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
@@ -52,24 +68,80 @@ func (p *BraveProvider) Search(ctx context.Context, logger *logrus.Logger, searc
 		"query":    query,
 	}).Debug("Brave search parameters")
 
+	region, err := internetsearch.ParseRegion(args)
+	if err != nil {
+		return nil, err
+	}
+
+	safeSearch, err := internetsearch.ParseSafeSearch(args)
+	if err != nil {
+		return nil, err
+	}
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := internetsearch.ParseDomainFilter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := SearchOptions{SafeSearch: safeSearch, Domains: domains}
+	if freshnessRaw, ok := args["freshness"].(string); ok {
+		opts.Freshness = freshnessRaw
+	}
+
+	// An explicit Brave freshness takes precedence over the unified time range
+	timeRangeApplied := false
+	if opts.Freshness == "" && timeRange != "" {
+		opts.Freshness = freshnessValues[timeRange]
+		timeRangeApplied = true
+	}
+	if region != nil {
+		// Brave uses "ALL" for results that are not tied to a country
+		opts.Country = region.Country
+		if opts.Country == "" {
+			opts.Country = "ALL"
+		}
+	}
+
+	var response *internetsearch.SearchResponse
 	switch searchType {
 	case "web":
-		return p.executeInternetSearch(ctx, logger, args)
+		response, err = p.executeInternetSearch(ctx, logger, args, opts)
 	case "image":
-		return p.executeImageSearch(ctx, logger, args)
+		response, err = p.executeImageSearch(ctx, logger, args, opts)
 	case "news":
-		return p.executeNewsSearch(ctx, logger, args)
+		response, err = p.executeNewsSearch(ctx, logger, args, opts)
 	case "video":
-		return p.executeVideoSearch(ctx, logger, args)
+		response, err = p.executeVideoSearch(ctx, logger, args, opts)
 	case "local":
-		return p.executeLocalSearch(ctx, logger, args)
+		response, err = p.executeLocalSearch(ctx, logger, args)
 	default:
 		return nil, fmt.Errorf("unsupported search type for Brave: %s", searchType)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Local search has no country parameter, so the region only applies to the other types
+	if region != nil && searchType != "local" {
+		response.Region = region.Code
+	}
+
+	// Image and local search have no freshness filter
+	if searchType == "image" || searchType == "local" {
+		response.ApplyTimeRange(timeRange, false)
+	} else if timeRangeApplied {
+		response.ApplyTimeRange(timeRange, true)
+	}
+	return response, nil
 }
 
 // executeInternetSearch handles internet search for web results
-func (p *BraveProvider) executeInternetSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
+func (p *BraveProvider) executeInternetSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts SearchOptions) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
 
 	// Parse optional parameters
@@ -89,12 +161,7 @@ func (p *BraveProvider) executeInternetSearch(ctx context.Context, logger *logru
 		}
 	}
 
-	freshness := ""
-	if freshnessRaw, ok := args["freshness"].(string); ok {
-		freshness = freshnessRaw
-	}
-
-	response, err := p.client.InternetSearch(ctx, logger, query, count, offset, freshness)
+	response, err := p.client.InternetSearch(ctx, logger, query, count, offset, opts)
 	if err != nil {
 		return nil, fmt.Errorf("internet search failed: %w", err)
 	}
@@ -110,6 +177,15 @@ func (p *BraveProvider) executeInternetSearch(ctx context.Context, logger *logru
 		if webResult.Age != "" {
 			metadata["age"] = webResult.Age
 		}
+		if webResult.PageAge != "" {
+			metadata["page_age"] = webResult.PageAge
+		}
+		if webResult.MetaURL != nil && webResult.MetaURL.Favicon != "" {
+			metadata["favicon_url"] = webResult.MetaURL.Favicon
+		}
+		if webResult.Language != "" {
+			metadata["language"] = webResult.Language
+		}
 
 		results = append(results, internetsearch.SearchResult{
 			Title:       decodeHTMLEntities(webResult.Title),
@@ -123,7 +199,7 @@ func (p *BraveProvider) executeInternetSearch(ctx context.Context, logger *logru
 }
 
 // executeImageSearch handles image search
-func (p *BraveProvider) executeImageSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
+func (p *BraveProvider) executeImageSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts SearchOptions) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
 
 	// Parse optional parameters
@@ -135,7 +211,7 @@ func (p *BraveProvider) executeImageSearch(ctx context.Context, logger *logrus.L
 		}
 	}
 
-	response, err := p.client.ImageSearch(ctx, logger, query, count)
+	response, err := p.client.ImageSearch(ctx, logger, query, count, opts)
 	if err != nil {
 		return nil, fmt.Errorf("image search failed: %w", err)
 	}
@@ -171,7 +247,7 @@ func (p *BraveProvider) executeImageSearch(ctx context.Context, logger *logrus.L
 }
 
 // executeNewsSearch handles news search
-func (p *BraveProvider) executeNewsSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
+func (p *BraveProvider) executeNewsSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts SearchOptions) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
 
 	// Parse optional parameters
@@ -183,12 +259,7 @@ func (p *BraveProvider) executeNewsSearch(ctx context.Context, logger *logrus.Lo
 		}
 	}
 
-	freshness := ""
-	if freshnessRaw, ok := args["freshness"].(string); ok {
-		freshness = freshnessRaw
-	}
-
-	response, err := p.client.NewsSearch(ctx, logger, query, count, freshness)
+	response, err := p.client.NewsSearch(ctx, logger, query, count, opts)
 	if err != nil {
 		return nil, fmt.Errorf("news search failed: %w", err)
 	}
@@ -204,6 +275,12 @@ func (p *BraveProvider) executeNewsSearch(ctx context.Context, logger *logrus.Lo
 		if newsResult.Age != "" {
 			metadata["age"] = newsResult.Age
 		}
+		if newsResult.PageAge != "" {
+			metadata["page_age"] = newsResult.PageAge
+		}
+		if newsResult.MetaURL != nil && newsResult.MetaURL.Favicon != "" {
+			metadata["favicon_url"] = newsResult.MetaURL.Favicon
+		}
 
 		results = append(results, internetsearch.SearchResult{
 			Title:       decodeHTMLEntities(newsResult.Title),
@@ -217,7 +294,7 @@ func (p *BraveProvider) executeNewsSearch(ctx context.Context, logger *logrus.Lo
 }
 
 // executeVideoSearch handles video search
-func (p *BraveProvider) executeVideoSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
+func (p *BraveProvider) executeVideoSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts SearchOptions) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
 
 	// Parse optional parameters
@@ -229,12 +306,7 @@ func (p *BraveProvider) executeVideoSearch(ctx context.Context, logger *logrus.L
 		}
 	}
 
-	freshness := ""
-	if freshnessRaw, ok := args["freshness"].(string); ok {
-		freshness = freshnessRaw
-	}
-
-	response, err := p.client.VideoSearch(ctx, logger, query, count, freshness)
+	response, err := p.client.VideoSearch(ctx, logger, query, count, opts)
 	if err != nil {
 		return nil, fmt.Errorf("video search failed: %w", err)
 	}
@@ -293,7 +365,7 @@ func (p *BraveProvider) executeLocalSearch(ctx context.Context, logger *logrus.L
 
 	// Fallback to internet search
 	logger.WithField("query", query).Info("No location results found, falling back to internet search")
-	webResponse, err := p.client.InternetSearch(ctx, logger, query, count, 0, "")
+	webResponse, err := p.client.InternetSearch(ctx, logger, query, count, 0, SearchOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("local search found no results and fallback internet search failed: %w", err)
 	}
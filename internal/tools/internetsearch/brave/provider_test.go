@@ -0,0 +1,312 @@
+package brave
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+// newTestProvider creates a Brave provider pointed at a test server
+func newTestProvider(server *httptest.Server) *BraveProvider {
+	return &BraveProvider{
+		client: &BraveClient{
+			apiKey:     "test-key",
+			baseURL:    server.URL,
+			httpClient: server.Client(),
+		},
+	}
+}
+
+func TestBraveProvider_GetSupportedTypes(t *testing.T) {
+	provider := &BraveProvider{client: NewBraveClient("test-key")}
+
+	supported := provider.GetSupportedTypes()
+	for _, expected := range []string{"web", "news", "image", "video"} {
+		found := false
+		for _, searchType := range supported {
+			if searchType == expected {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Expected %q in supported types, got %v", expected, supported)
+		}
+	}
+}
+
+func TestBraveProvider_WebSearchFixture(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(testutils.FixtureHandler(t, "web_search.json", "application/json", http.StatusOK, &received))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	args := map[string]any{
+		"query":     "golang best practices",
+		"count":     float64(5),
+		"offset":    float64(2),
+		"freshness": "pw",
+	}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", args)
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.URL.Path != "/web/search" {
+		t.Errorf("Expected request to /web/search, got %s", received.URL.Path)
+	}
+	if received.Header.Get("X-Subscription-Token") != "test-key" {
+		t.Errorf("Expected X-Subscription-Token header to carry the API key")
+	}
+	query := received.URL.Query()
+	if query.Get("q") != "golang best practices" || query.Get("count") != "5" || query.Get("offset") != "2" || query.Get("freshness") != "pw" {
+		t.Errorf("Unexpected query parameters: %v", query)
+	}
+
+	if len(response.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(response.Results))
+	}
+
+	first := response.Results[0]
+	if first.Description != "Tips for writing clear, idiomatic Go code." {
+		t.Errorf("Expected HTML to be stripped from description, got %q", first.Description)
+	}
+	if first.Metadata["page_age"] != "2024-05-01T00:00:00" {
+		t.Errorf("Expected page_age metadata, got %v", first.Metadata["page_age"])
+	}
+	if first.Metadata["language"] != "en" {
+		t.Errorf("Expected language metadata, got %v", first.Metadata["language"])
+	}
+	if _, ok := response.Results[1].Metadata["page_age"]; ok {
+		t.Errorf("Expected no page_age metadata when absent from response")
+	}
+	if first.Metadata["favicon_url"] != "https://imgs.search.brave.com/go-dev-favicon.png" {
+		t.Errorf("Expected favicon_url metadata from meta_url, got %v", first.Metadata["favicon_url"])
+	}
+	if _, ok := response.Results[1].Metadata["favicon_url"]; ok {
+		t.Errorf("Expected no favicon_url metadata without meta_url")
+	}
+}
+
+func TestBraveProvider_NewsSearchFixture(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(testutils.FixtureHandler(t, "news_search.json", "application/json", http.StatusOK, &received))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "go release"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.URL.Path != "/news/search" {
+		t.Errorf("Expected request to /news/search, got %s", received.URL.Path)
+	}
+	if len(response.Results) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(response.Results))
+	}
+	if response.Results[0].Metadata["page_age"] != "2024-08-13T00:00:00" {
+		t.Errorf("Expected page_age metadata, got %v", response.Results[0].Metadata["page_age"])
+	}
+}
+
+func TestBraveProvider_RateLimitedReturnsTypedError(t *testing.T) {
+	handler := testutils.FixtureHandler(t, "rate_limited.json", "application/json", http.StatusTooManyRequests, nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "test"})
+	if err == nil {
+		t.Fatal("Expected rate limit error, got success")
+	}
+
+	var rateLimitErr *internetsearch.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got %T: %v", err, err)
+	}
+	if rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf("Expected RetryAfter of 30s, got %s", rateLimitErr.RetryAfter)
+	}
+	if rateLimitErr.Provider != "brave" {
+		t.Errorf("Expected provider brave, got %s", rateLimitErr.Provider)
+	}
+}
+
+func TestBraveProvider_InvalidCount(t *testing.T) {
+	provider := &BraveProvider{client: NewBraveClient("test-key")}
+
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query": "test",
+		"count": float64(25),
+	})
+	if err == nil {
+		t.Error("Expected error for count > 20")
+	}
+}
+
+func TestBraveProvider_Region(t *testing.T) {
+	tests := []struct {
+		region  string
+		country string
+	}{
+		{region: "uk-en", country: "GB"},
+		{region: "de-de", country: "DE"},
+		{region: "wt-wt", country: "ALL"},
+	}
+
+	for _, tt := range tests {
+		var received *http.Request
+		server := httptest.NewServer(testutils.FixtureHandler(t, "news_search.json", "application/json", http.StatusOK, &received))
+
+		provider := newTestProvider(server)
+		response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{
+			"query":  "golang",
+			"region": tt.region,
+		})
+		server.Close()
+		if err != nil {
+			t.Fatalf("Region %s: expected success, got error: %v", tt.region, err)
+		}
+
+		if got := received.URL.Query().Get("country"); got != tt.country {
+			t.Errorf("Region %s: expected country=%s, got %q", tt.region, tt.country, got)
+		}
+		if response.Region != tt.region {
+			t.Errorf("Region %s: expected effective region to be recorded, got %q", tt.region, response.Region)
+		}
+	}
+}
+
+func TestBraveProvider_SafeSearch(t *testing.T) {
+	tests := []struct {
+		searchType string
+		fixture    string
+		safeSearch string
+		expected   string
+	}{
+		{searchType: "web", fixture: "web_search.json", safeSearch: "", expected: "moderate"},
+		{searchType: "web", fixture: "web_search.json", safeSearch: "off", expected: "off"},
+		{searchType: "web", fixture: "web_search.json", safeSearch: "strict", expected: "strict"},
+		{searchType: "news", fixture: "news_search.json", safeSearch: "strict", expected: "strict"},
+		// The image endpoint has no moderate level, so Brave's default applies
+		{searchType: "image", fixture: "news_search.json", safeSearch: "moderate", expected: ""},
+		{searchType: "image", fixture: "news_search.json", safeSearch: "off", expected: "off"},
+	}
+
+	for _, tt := range tests {
+		var received *http.Request
+		server := httptest.NewServer(testutils.FixtureHandler(t, tt.fixture, "application/json", http.StatusOK, &received))
+
+		args := map[string]any{"query": "golang", "count": float64(1)}
+		if tt.safeSearch != "" {
+			args["safesearch"] = tt.safeSearch
+		}
+		_, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), tt.searchType, args)
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s/%q: expected success, got error: %v", tt.searchType, tt.safeSearch, err)
+		}
+
+		if got := received.URL.Query().Get("safesearch"); got != tt.expected {
+			t.Errorf("%s/%q: expected safesearch=%q, got %q", tt.searchType, tt.safeSearch, tt.expected, got)
+		}
+	}
+}
+
+func TestBraveProvider_TimeRange(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(testutils.FixtureHandler(t, "web_search.json", "application/json", http.StatusOK, &received))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":      "golang",
+		"offset":     float64(3),
+		"region":     "au-en",
+		"time_range": "week",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	query := received.URL.Query()
+	if query.Get("freshness") != "pw" || query.Get("offset") != "3" || query.Get("country") != "AU" {
+		t.Errorf("Unexpected query parameters: %v", query)
+	}
+	if response.TimeRange != "week" {
+		t.Errorf("Expected applied time range to be echoed, got %q", response.TimeRange)
+	}
+
+	// An explicit freshness wins over the unified time range
+	response, err = provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":      "golang",
+		"freshness":  "2024-01-01to2024-02-01",
+		"time_range": "day",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("freshness"); got != "2024-01-01to2024-02-01" {
+		t.Errorf("Expected explicit freshness to be sent, got %q", got)
+	}
+	if response.TimeRange != "" {
+		t.Errorf("Expected time range not to be echoed when freshness overrides it, got %q", response.TimeRange)
+	}
+}
+
+func TestBraveProvider_TimeRangeUnsupportedForImages(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(testutils.FixtureHandler(t, "news_search.json", "application/json", http.StatusOK, &received))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "image", map[string]any{
+		"query":      "gopher",
+		"count":      float64(1),
+		"time_range": "day",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.URL.Query().Get("freshness") != "" {
+		t.Errorf("Expected no freshness for image search")
+	}
+	if _, ok := response.Metadata["time_range_unsupported"]; !ok {
+		t.Errorf("Expected time_range_unsupported metadata, got %v", response.Metadata)
+	}
+}
+
+func TestBraveProvider_DomainFilters(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(testutils.FixtureHandler(t, "web_search.json", "application/json", http.StatusOK, &received))
+	defer server.Close()
+
+	_, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":           "golang",
+		"include_domains": []any{"go.dev", "https://github.com/golang"},
+		"exclude_domains": []any{"www.pinterest.com"},
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("q"); got != "golang (site:go.dev OR site:github.com) -site:pinterest.com" {
+		t.Errorf("Expected site: operators in query, got %q", got)
+	}
+
+	if _, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":           "golang",
+		"exclude_domains": []any{"not a domain"},
+	}); err == nil {
+		t.Error("Expected error for invalid domain")
+	}
+}
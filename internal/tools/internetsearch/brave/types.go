@@ -84,10 +84,18 @@ type BraveInternetSearchResult struct {
 
 // BraveWebResult represents a single internet search result
 type BraveWebResult struct {
-	Title       string `json:"title"`
-	URL         string `json:"url"`
-	Description string `json:"description"`
-	Age         string `json:"age,omitempty"`
+	Title       string        `json:"title"`
+	URL         string        `json:"url"`
+	Description string        `json:"description"`
+	Age         string        `json:"age,omitempty"`
+	PageAge     string        `json:"page_age,omitempty"`
+	Language    string        `json:"language,omitempty"`
+	MetaURL     *BraveMetaURL `json:"meta_url,omitempty"`
+}
+
+// BraveMetaURL describes the site a result belongs to
+type BraveMetaURL struct {
+	Favicon string `json:"favicon,omitempty"`
 }
 
 // BraveImageSearchResponse represents the response from Brave image search API
@@ -122,10 +130,12 @@ type BraveNewsSearchResponse struct {
 
 // BraveNewsResult represents a single news search result
 type BraveNewsResult struct {
-	Title       string `json:"title"`
-	URL         string `json:"url"`
-	Description string `json:"description"`
-	Age         string `json:"age"`
+	Title       string        `json:"title"`
+	URL         string        `json:"url"`
+	Description string        `json:"description"`
+	Age         string        `json:"age"`
+	PageAge     string        `json:"page_age,omitempty"`
+	MetaURL     *BraveMetaURL `json:"meta_url,omitempty"`
 }
 
 // BraveVideoSearchResponse represents the response from Brave video search API
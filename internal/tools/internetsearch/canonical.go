@@ -0,0 +1,124 @@
+package internetsearch
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// trackingParams are query parameters that identify the referrer rather than the page
+var trackingParams = map[string]bool{
+	"fbclid":  true,
+	"gclid":   true,
+	"dclid":   true,
+	"msclkid": true,
+	"yclid":   true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"_ga":     true,
+	"_gl":     true,
+	"igshid":  true,
+	"ref_src": true,
+}
+
+// CanonicalURL returns a comparison key for a URL so the same page is recognised despite
+// superficial differences: http/https, a "www." prefix on a registered domain, host case,
+// Unicode or punycode IDN hosts, default ports, fragments, trailing slashes and tracking
+// parameters. The path and remaining query are kept as-is since they may be case-sensitive.
+// URLs that can't be parsed as http(s) are returned trimmed.
+func CanonicalURL(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return rawURL
+	}
+
+	scheme := strings.ToLower(parsed.Scheme)
+	if scheme != "http" && scheme != "https" && scheme != "" {
+		return rawURL
+	}
+
+	parsedHost, ok := hostOf(parsed)
+	if !ok {
+		return rawURL
+	}
+	host := parsedHost.canonical()
+	port := parsed.Port()
+	if (port == "80" && scheme == "http") || (port == "443" && scheme == "https") {
+		port = ""
+	}
+	switch {
+	case port != "":
+		host = net.JoinHostPort(host, port)
+	case strings.Contains(host, ":"):
+		host = "[" + host + "]" // IPv6 literal
+	}
+
+	path := parsed.EscapedPath()
+	if path == "" {
+		path = "/"
+	} else if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	canonical := "https://" + host + path
+	if query := canonicalQuery(parsed.RawQuery); query != "" {
+		canonical += "?" + query
+	}
+	return canonical
+}
+
+// canonicalQuery removes tracking parameters, keeping the remaining parameters in their original order
+func canonicalQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	var kept []string
+	for param := range strings.SplitSeq(rawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(param, "=")
+		if decoded, err := url.QueryUnescape(name); err == nil {
+			name = decoded
+		}
+		name = strings.ToLower(name)
+		if trackingParams[name] || strings.HasPrefix(name, "utm_") {
+			continue
+		}
+		kept = append(kept, param)
+	}
+	return strings.Join(kept, "&")
+}
+
+// URLDeduplicator tracks the canonical URLs of results already added to a response
+type URLDeduplicator struct {
+	seen    map[string]bool
+	removed int
+}
+
+// NewURLDeduplicator creates an empty URLDeduplicator
+func NewURLDeduplicator() *URLDeduplicator {
+	return &URLDeduplicator{seen: make(map[string]bool)}
+}
+
+// Duplicate reports whether an equivalent URL has already been added, counting it as removed if so.
+// Otherwise it records the URL, so results should be checked in rank order to keep the higher ranked entry.
+func (d *URLDeduplicator) Duplicate(rawURL string) bool {
+	key := CanonicalURL(rawURL)
+	if key == "" {
+		return false
+	}
+	if d.seen[key] {
+		d.removed++
+		return true
+	}
+	d.seen[key] = true
+	return false
+}
+
+// Removed returns the number of duplicates found
+func (d *URLDeduplicator) Removed() int {
+	return d.removed
+}
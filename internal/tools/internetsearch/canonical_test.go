@@ -0,0 +1,78 @@
+package internetsearch
+
+import "testing"
+
+func TestCanonicalURL(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		same bool
+	}{
+		{name: "http and https", a: "http://go.dev/doc", b: "https://go.dev/doc", same: true},
+		{name: "www prefix", a: "https://www.go.dev/doc", b: "https://go.dev/doc", same: true},
+		{name: "host case", a: "https://GO.dev/doc", b: "https://go.dev/doc", same: true},
+		{name: "trailing slash", a: "https://go.dev/doc/", b: "https://go.dev/doc", same: true},
+		{name: "root with and without slash", a: "https://go.dev", b: "https://go.dev/", same: true},
+		{name: "fragment", a: "https://go.dev/doc#install", b: "https://go.dev/doc", same: true},
+		{name: "default https port", a: "https://go.dev:443/doc", b: "https://go.dev/doc", same: true},
+		{name: "default http port", a: "http://go.dev:80/doc", b: "https://go.dev/doc", same: true},
+		{name: "trailing dot in host", a: "https://go.dev./doc", b: "https://go.dev/doc", same: true},
+		{name: "utm parameters", a: "https://go.dev/doc?utm_source=news&utm_medium=email", b: "https://go.dev/doc", same: true},
+		{name: "mixed case tracking parameter", a: "https://go.dev/doc?FBCLID=abc&v=1", b: "https://go.dev/doc?v=1", same: true},
+		{name: "tracking with meaningful query", a: "https://example.com/watch?v=abc&gclid=xyz", b: "https://example.com/watch?v=abc", same: true},
+		{name: "path case is preserved", a: "https://github.com/Sammcj/Repo", b: "https://github.com/sammcj/repo", same: false},
+		{name: "query case is preserved", a: "https://example.com/?id=ABC", b: "https://example.com/?id=abc", same: false},
+		{name: "different query values", a: "https://example.com/watch?v=abc", b: "https://example.com/watch?v=def", same: false},
+		{name: "non default port", a: "https://example.com:8443/", b: "https://example.com/", same: false},
+		{name: "https on port 80 is not default", a: "https://example.com:80/", b: "https://example.com/", same: false},
+		{name: "subdomain is not www", a: "https://docs.example.com/", b: "https://example.com/", same: false},
+		{name: "encoded slash is not a separator", a: "https://example.com/a%2Fb", b: "https://example.com/a/b", same: false},
+		{name: "double trailing slash", a: "https://example.com/a//", b: "https://example.com/a", same: false},
+		{name: "ipv6 host", a: "http://[::1]:80/status", b: "https://[::1]/status", same: true},
+		{name: "unicode and punycode idn host", a: "https://www.münchen.de/rathaus", b: "https://xn--mnchen-3ya.de/rathaus", same: true},
+		{name: "www as a registered domain is kept", a: "https://www.com/", b: "https://com/", same: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := CanonicalURL(tt.a), CanonicalURL(tt.b)
+			if (a == b) != tt.same {
+				t.Errorf("CanonicalURL(%q) = %q, CanonicalURL(%q) = %q, expected same=%v", tt.a, a, tt.b, b, tt.same)
+			}
+		})
+	}
+}
+
+func TestCanonicalURL_Unparseable(t *testing.T) {
+	for _, raw := range []string{"", "not a url", "mailto:someone@example.com", "ftp://example.com/file", "  /relative/path  "} {
+		want := raw
+		if raw == "  /relative/path  " {
+			want = "/relative/path"
+		}
+		if got := CanonicalURL(raw); got != want {
+			t.Errorf("CanonicalURL(%q) = %q, expected the trimmed input", raw, got)
+		}
+	}
+}
+
+func TestURLDeduplicator(t *testing.T) {
+	dedup := NewURLDeduplicator()
+	urls := []string{
+		"https://go.dev/doc/",
+		"http://www.go.dev/doc#top",
+		"https://go.dev/blog",
+		"https://go.dev/doc?utm_campaign=x",
+		"",
+		"",
+	}
+	want := []bool{false, true, false, true, false, false}
+
+	for i, u := range urls {
+		if got := dedup.Duplicate(u); got != want[i] {
+			t.Errorf("Duplicate(%q) = %v, expected %v", u, got, want[i])
+		}
+	}
+	if dedup.Removed() != 2 {
+		t.Errorf("Expected 2 duplicates removed, got %d", dedup.Removed())
+	}
+}
@@ -0,0 +1,196 @@
+package internetsearch
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date patterns providers put at the start of snippets, e.g. "3 days ago · ..." or "Jan 5, 2024 ... "
+var (
+	relativeDatePattern   = regexp.MustCompile(`(?i)^(\d+|an?|one)\s+(second|sec|minute|min|hour|hr|day|week|month|year)s?\s+ago\b`)
+	yesterdayPattern      = regexp.MustCompile(`(?i)^yesterday\b`)
+	monthFirstDatePattern = regexp.MustCompile(`(?i)^([a-z]{3,9})\.?\s+(\d{1,2}),?\s+(\d{4})\b`)
+	dayFirstDatePattern   = regexp.MustCompile(`(?i)^(\d{1,2})\s+([a-z]{3,9})\.?,?\s+(\d{4})\b`)
+	isoDatePattern        = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})\b`)
+
+	// dateSeparatorPattern matches what separates a leading date from the rest of the snippet
+	dateSeparatorPattern = regexp.MustCompile(`^\s*(?:[·•—–\-|:]|\.\.\.|…)?\s*`)
+)
+
+// relativeUnits maps the units of relative dates to their durations, with months and years handled by AddDate
+var relativeUnits = map[string]time.Duration{
+	"second": time.Second,
+	"sec":    time.Second,
+	"minute": time.Minute,
+	"min":    time.Minute,
+	"hour":   time.Hour,
+	"hr":     time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+}
+
+// publishedLayouts are the formats of provider supplied "published" metadata that can be normalised
+var publishedLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+// ExtractLeadingDate parses a date at the start of text, with relative dates ("3 days ago", "yesterday")
+// counted back from now. It returns the date and the text with the date and its separator removed,
+// reporting false and leaving text alone when there's no date or it isn't a real one (e.g. "Feb 30, 2024").
+func ExtractLeadingDate(text string, now time.Time) (time.Time, string, bool) {
+	trimmed := strings.TrimSpace(text)
+
+	var (
+		date   time.Time
+		length int
+		ok     bool
+	)
+	if match := relativeDatePattern.FindStringSubmatch(trimmed); match != nil {
+		date, ok = relativeDate(match[1], strings.ToLower(match[2]), now)
+		length = len(match[0])
+	} else if match := yesterdayPattern.FindString(trimmed); match != "" {
+		date, ok = now.AddDate(0, 0, -1), true
+		length = len(match)
+	} else if match := monthFirstDatePattern.FindStringSubmatch(trimmed); match != nil {
+		date, ok = calendarDate(match[3], match[1], match[2])
+		length = len(match[0])
+	} else if match := dayFirstDatePattern.FindStringSubmatch(trimmed); match != nil {
+		date, ok = calendarDate(match[3], match[2], match[1])
+		length = len(match[0])
+	} else if match := isoDatePattern.FindStringSubmatch(trimmed); match != nil {
+		month, _ := strconv.Atoi(match[2])
+		date, ok = calendarDate(match[1], time.Month(month).String(), match[3])
+		length = len(match[0])
+	}
+	if !ok {
+		return time.Time{}, text, false
+	}
+
+	rest := trimmed[length:]
+	rest = rest[len(dateSeparatorPattern.FindString(rest)):]
+	return date.UTC(), rest, true
+}
+
+// relativeDate counts amount units back from now
+func relativeDate(amount, unit string, now time.Time) (time.Time, bool) {
+	n := 1
+	if amount[0] >= '0' && amount[0] <= '9' {
+		var err error
+		if n, err = strconv.Atoi(amount); err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	switch unit {
+	case "month":
+		return now.AddDate(0, -n, 0), true
+	case "year":
+		return now.AddDate(-n, 0, 0), true
+	default:
+		return now.Add(-time.Duration(n) * relativeUnits[unit]), true
+	}
+}
+
+// calendarDate builds a date from its parts, accepting full or three letter English month names
+// and rejecting days the month doesn't have
+func calendarDate(year, monthName, day string) (time.Time, bool) {
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return time.Time{}, false
+	}
+	d, err := strconv.Atoi(day)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	monthName = strings.ToLower(monthName)
+	for month := time.January; month <= time.December; month++ {
+		name := strings.ToLower(month.String())
+		if monthName != name && monthName != name[:3] && !(month == time.September && monthName == "sept") {
+			continue
+		}
+		date := time.Date(y, month, d, 0, 0, 0, 0, time.UTC)
+		if date.Day() != d || date.Month() != month {
+			return time.Time{}, false
+		}
+		return date, true
+	}
+	return time.Time{}, false
+}
+
+// AddPublishedDates records each result's "published_at" as RFC3339. Dates leading the description are
+// extracted and removed from it, relative ones counted back from now (the response timestamp);
+// otherwise a provider supplied "published" date is normalised when it can be parsed.
+func AddPublishedDates(results []SearchResult, now time.Time) {
+	for i := range results {
+		result := &results[i]
+		if _, ok := result.Metadata["published_at"]; ok {
+			continue
+		}
+
+		date, description, ok := ExtractLeadingDate(result.Description, now)
+		if ok {
+			result.Description = description
+		} else {
+			published, _ := result.Metadata["published"].(string)
+			date, ok = parsePublished(published)
+		}
+		if !ok {
+			continue
+		}
+		if result.Metadata == nil {
+			result.Metadata = make(map[string]any)
+		}
+		result.Metadata["published_at"] = date.Format(time.RFC3339)
+	}
+}
+
+func parsePublished(published string) (time.Time, bool) {
+	for _, layout := range publishedLayouts {
+		if date, err := time.Parse(layout, strings.TrimSpace(published)); err == nil {
+			return date.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// SortByDate orders results newest first by their "published_at" metadata, keeping undated results after
+// them in rank order. It leaves results in rank order and reports false when fewer than half have a date,
+// as a mostly undated list sorted by date would be more misleading than helpful.
+func SortByDate(results []SearchResult) bool {
+	type datedResult struct {
+		result SearchResult
+		date   time.Time
+		dated  bool
+	}
+
+	ordered := make([]datedResult, len(results))
+	datedCount := 0
+	for i, result := range results {
+		published, _ := result.Metadata["published_at"].(string)
+		date, err := time.Parse(time.RFC3339, published)
+		ordered[i] = datedResult{result: result, date: date, dated: err == nil}
+		if err == nil {
+			datedCount++
+		}
+	}
+	if len(results) == 0 || datedCount*2 < len(results) {
+		return false
+	}
+
+	slices.SortStableFunc(ordered, func(a, b datedResult) int {
+		switch {
+		case a.dated && !b.dated:
+			return -1
+		case !a.dated && b.dated:
+			return 1
+		default:
+			return b.date.Compare(a.date)
+		}
+	})
+	for i := range ordered {
+		results[i] = ordered[i].result
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package internetsearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExtractLeadingDate(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		text     string
+		wantDate string // Empty when no date should be found
+		wantRest string
+	}{
+		{name: "days ago", text: "3 days ago · Go 1.22 adds range over integers", wantDate: "2024-03-07T15:30:00Z", wantRest: "Go 1.22 adds range over integers"},
+		{name: "an hour ago", text: "an hour ago — Release notes", wantDate: "2024-03-10T14:30:00Z", wantRest: "Release notes"},
+		{name: "months ago", text: "2 months ago ... Generics tutorial", wantDate: "2024-01-10T15:30:00Z", wantRest: "Generics tutorial"},
+		{name: "yesterday", text: "Yesterday - The Go blog", wantDate: "2024-03-09T15:30:00Z", wantRest: "The Go blog"},
+		{name: "month first", text: "Jan 5, 2024 ... The Go 1.22 release", wantDate: "2024-01-05T00:00:00Z", wantRest: "The Go 1.22 release"},
+		{name: "full month name", text: "September 12, 2023 · Announcing Go 1.21", wantDate: "2023-09-12T00:00:00Z", wantRest: "Announcing Go 1.21"},
+		{name: "day first", text: "5 Jan 2024 — Release notes", wantDate: "2024-01-05T00:00:00Z", wantRest: "Release notes"},
+		{name: "iso", text: "2024-01-05: Release notes", wantDate: "2024-01-05T00:00:00Z", wantRest: "Release notes"},
+		{name: "date only", text: "Jan 5, 2024", wantDate: "2024-01-05T00:00:00Z", wantRest: ""},
+		{name: "impossible day", text: "Feb 30, 2024 · Release notes"},
+		{name: "not a month", text: "Add 5, 2024 items to the list"},
+		{name: "date later in the text", text: "Released on Jan 5, 2024 with range over integers"},
+		{name: "no ago", text: "3 days of talks at GopherCon"},
+		{name: "empty", text: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, rest, ok := ExtractLeadingDate(tt.text, now)
+			if tt.wantDate == "" {
+				if ok || rest != tt.text {
+					t.Errorf("Expected %q to be left alone, got %v and %q", tt.text, date, rest)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("Expected a date in %q", tt.text)
+			}
+			if got := date.Format(time.RFC3339); got != tt.wantDate || rest != tt.wantRest {
+				t.Errorf("Expected %s and %q, got %s and %q", tt.wantDate, tt.wantRest, got, rest)
+			}
+		})
+	}
+}
+
+func TestAddPublishedDates(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	results := []SearchResult{
+		{Description: "2 days ago · Range over integers"},
+		{Description: "Feb 30, 2024 · Not a real date"},
+		{Description: "Release notes", Metadata: map[string]any{"published": "2023-08-08"}},
+		{Description: "Yesterday · Already dated", Metadata: map[string]any{"published_at": "2020-01-01T00:00:00Z"}},
+	}
+	AddPublishedDates(results, now)
+
+	if results[0].Metadata["published_at"] != "2024-03-08T00:00:00Z" || results[0].Description != "Range over integers" {
+		t.Errorf("Expected the snippet date to be extracted and stripped, got %+v", results[0])
+	}
+	if _, ok := results[1].Metadata["published_at"]; ok || results[1].Description != "Feb 30, 2024 · Not a real date" {
+		t.Errorf("Expected an unparseable date to be left alone, got %+v", results[1])
+	}
+	if results[2].Metadata["published_at"] != "2023-08-08T00:00:00Z" {
+		t.Errorf("Expected the provider's published date to be normalised, got %v", results[2].Metadata)
+	}
+	if results[3].Metadata["published_at"] != "2020-01-01T00:00:00Z" || results[3].Description != "Yesterday · Already dated" {
+		t.Errorf("Expected an existing published_at to be kept, got %+v", results[3])
+	}
+}
+
+func TestSortByDate(t *testing.T) {
+	dated := func(url, published string) SearchResult {
+		return SearchResult{URL: url, Metadata: map[string]any{"published_at": published}}
+	}
+
+	results := []SearchResult{
+		dated("https://a.example/", "2023-01-01T00:00:00Z"),
+		{URL: "https://b.example/"},
+		dated("https://c.example/", "2024-01-01T00:00:00Z"),
+		dated("https://d.example/", "2023-06-01T00:00:00Z"),
+		{URL: "https://e.example/"},
+	}
+	if !SortByDate(results) {
+		t.Fatal("Expected results to be sorted when most have a date")
+	}
+	want := []string{"https://c.example/", "https://d.example/", "https://a.example/", "https://b.example/", "https://e.example/"}
+	for i, result := range results {
+		if result.URL != want[i] {
+			t.Errorf("Expected %s at %d, got %s", want[i], i, result.URL)
+		}
+	}
+
+	results = []SearchResult{
+		{URL: "https://a.example/"},
+		dated("https://b.example/", "2024-01-01T00:00:00Z"),
+		{URL: "https://c.example/"},
+	}
+	if SortByDate(results) || results[0].URL != "https://a.example/" {
+		t.Errorf("Expected rank order to be kept when most results are undated, got %+v", results)
+	}
+}
@@ -0,0 +1,285 @@
+package internetsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SearchDebugEnvVar enables debug capture for every search, as if debug: true was passed
+	SearchDebugEnvVar = "SEARCH_DEBUG"
+	// SearchDebugRetentionEnvVar configures how long captured response bodies are kept
+	SearchDebugRetentionEnvVar = "SEARCH_DEBUG_RETENTION"
+	// DefaultSearchDebugRetention is how long captured response bodies are kept by default
+	DefaultSearchDebugRetention = 24 * time.Hour
+
+	// MaxDebugBodySize is the most of a response body written to a debug file
+	MaxDebugBodySize = 2 * 1024 * 1024
+
+	// redacted replaces secret query parameter and header values
+	redacted = "REDACTED"
+)
+
+// secretHeaders are headers whose values are always redacted, alongside any whose name suggests a secret
+var secretHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// secretNameParts mark query parameters and headers holding credentials, e.g. "api_key" or "X-Subscription-Token"
+var secretNameParts = []string{"key", "token", "secret", "password", "signature"}
+
+// DebugResponse describes a raw provider response saved by debug capture. The URL and headers are redacted.
+type DebugResponse struct {
+	Provider  string            `json:"provider"`
+	URL       string            `json:"url,omitempty"`
+	Status    int               `json:"status"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	BodyFile  string            `json:"body_file,omitempty"`
+	BodyBytes int               `json:"body_bytes"`
+	Truncated bool              `json:"truncated,omitempty"` // Only the first MaxDebugBodySize bytes were saved
+	Error     string            `json:"error,omitempty"`     // Why the body couldn't be saved
+}
+
+// DebugCapture collects the raw responses of a search. It's safe for concurrent use, as federated searches
+// share one capture between providers.
+type DebugCapture struct {
+	dir       string
+	mu        sync.Mutex
+	responses []DebugResponse
+}
+
+type debugCaptureKey struct{}
+
+// DebugEnabled reports whether the debug argument or SEARCH_DEBUG asks for raw responses to be captured
+func DebugEnabled(args map[string]any) bool {
+	if debug, _ := args["debug"].(bool); debug {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(strings.TrimSpace(os.Getenv(SearchDebugEnvVar)))
+	return enabled
+}
+
+// DebugDir returns the directory captured response bodies are written to, ~/.mcp-devtools/debug
+func DebugDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".mcp-devtools", "debug"), nil
+}
+
+// getSearchDebugRetention returns the configured retention, accepting a duration ("12h") or a number of seconds
+func getSearchDebugRetention() time.Duration {
+	envValue := strings.TrimSpace(os.Getenv(SearchDebugRetentionEnvVar))
+	if envValue == "" {
+		return DefaultSearchDebugRetention
+	}
+	if seconds, err := strconv.Atoi(envValue); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if retention, err := time.ParseDuration(envValue); err == nil && retention > 0 {
+		return retention
+	}
+	return DefaultSearchDebugRetention
+}
+
+// NewDebugCapture creates the debug directory and removes files in it older than SEARCH_DEBUG_RETENTION
+func NewDebugCapture(logger *logrus.Logger) (*DebugCapture, error) {
+	dir, err := DebugDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create debug directory: %w", err)
+	}
+
+	if removed, err := pruneDebugFiles(dir, time.Now().Add(-getSearchDebugRetention())); err != nil {
+		logger.WithError(err).Warn("Failed to prune old search debug files")
+	} else if removed > 0 {
+		logger.WithField("removed", removed).Debug("Pruned old search debug files")
+	}
+	return &DebugCapture{dir: dir}, nil
+}
+
+// pruneDebugFiles removes regular files in dir last modified before cutoff, returning how many were removed
+func pruneDebugFiles(dir string, cutoff time.Time) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.ModTime().Before(cutoff) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// WithDebugCapture returns a context that providers record their raw responses to
+func WithDebugCapture(ctx context.Context, capture *DebugCapture) context.Context {
+	return context.WithValue(ctx, debugCaptureKey{}, capture)
+}
+
+// CaptureResponse saves a provider's raw response body when the context carries a debug capture,
+// and does nothing otherwise. Failing to save the body is recorded rather than failing the search.
+func CaptureResponse(ctx context.Context, logger *logrus.Logger, provider string, resp *http.Response, body []byte) {
+	capture, ok := ctx.Value(debugCaptureKey{}).(*DebugCapture)
+	if !ok || capture == nil || resp == nil {
+		return
+	}
+
+	response := DebugResponse{
+		Provider:  provider,
+		Status:    resp.StatusCode,
+		Headers:   RedactHeaders(resp.Header),
+		BodyBytes: len(body),
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		response.URL = RedactURL(resp.Request.URL)
+	}
+
+	saved := body
+	if len(saved) > MaxDebugBodySize {
+		saved = saved[:MaxDebugBodySize]
+		response.Truncated = true
+	}
+	if path, err := capture.write(provider, resp.Header.Get("Content-Type"), saved); err != nil {
+		response.Error = err.Error()
+	} else {
+		response.BodyFile = path
+	}
+
+	logger.WithFields(logrus.Fields{
+		"provider":  provider,
+		"url":       response.URL,
+		"status":    response.Status,
+		"body_file": response.BodyFile,
+	}).Info("Captured raw search provider response")
+
+	capture.mu.Lock()
+	capture.responses = append(capture.responses, response)
+	capture.mu.Unlock()
+}
+
+// write saves a body to a new file named after the time and provider, e.g. 20240105-153000.000-brave-123.json
+func (c *DebugCapture) write(provider, contentType string, body []byte) (string, error) {
+	pattern := fmt.Sprintf("%s-%s-*%s", time.Now().UTC().Format("20060102-150405.000"), provider, debugFileExtension(contentType))
+	file, err := os.CreateTemp(c.dir, pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create debug file: %w", err)
+	}
+	if _, err := file.Write(body); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("failed to write debug file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write debug file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// debugFileExtension picks an extension for a response body so it opens in a suitable viewer
+func debugFileExtension(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case strings.Contains(mediaType, "json"):
+		return ".json"
+	case strings.Contains(mediaType, "html"):
+		return ".html"
+	case strings.Contains(mediaType, "xml"):
+		return ".xml"
+	default:
+		return ".txt"
+	}
+}
+
+// Responses returns the responses captured so far, in the order they were received
+func (c *DebugCapture) Responses() []DebugResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.responses)
+}
+
+// isSecretName reports whether a query parameter or header name suggests it holds a credential
+func isSecretName(name string) bool {
+	name = strings.ToLower(name)
+	for _, part := range secretNameParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactURL returns the URL with credentials in its user info and query parameters replaced
+func RedactURL(u *url.URL) string {
+	redactedURL := *u
+	if redactedURL.User != nil {
+		redactedURL.User = url.User(redacted)
+	}
+
+	query := redactedURL.Query()
+	changed := false
+	for name, values := range query {
+		if isSecretName(name) {
+			for i := range values {
+				values[i] = redacted
+			}
+			changed = true
+		}
+	}
+	if changed {
+		redactedURL.RawQuery = query.Encode()
+	}
+	return redactedURL.String()
+}
+
+// RedactURLError redacts the URL an HTTP client error reports, as net/http includes the full request URL
+// (and any API key in its query) in the error message
+func RedactURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if parsed, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			urlErr.URL = RedactURL(parsed)
+		}
+	}
+	return err
+}
+
+// RedactHeaders flattens headers to one value each, replacing credentials and cookies
+func RedactHeaders(headers http.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	flattened := make(map[string]string, len(headers))
+	for name, values := range headers {
+		canonical := http.CanonicalHeaderKey(name)
+		if slices.Contains(secretHeaders, canonical) || isSecretName(canonical) {
+			flattened[canonical] = redacted
+			continue
+		}
+		flattened[canonical] = strings.Join(values, ", ")
+	}
+	return flattened
+}
@@ -0,0 +1,150 @@
+package internetsearch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newDebugTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestCaptureResponse(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	logger := newDebugTestLogger()
+
+	capture, err := NewDebugCapture(logger)
+	if err != nil {
+		t.Fatalf("Expected a debug capture, got error: %v", err)
+	}
+	ctx := WithDebugCapture(context.Background(), capture)
+
+	requestURL, _ := url.Parse("https://www.googleapis.com/customsearch/v1?key=secret-key&cx=engine&q=golang")
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":          {"application/json; charset=utf-8"},
+			"X-Subscription-Token":  {"secret-token"},
+			"Set-Cookie":            {"session=abc"},
+			"X-Ratelimit-Remaining": {"99"},
+		},
+		Request: &http.Request{URL: requestURL},
+	}
+	CaptureResponse(ctx, logger, "google", resp, []byte(`{"items": []}`))
+
+	large := &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"text/html"}}}
+	CaptureResponse(ctx, logger, "duckduckgo", large, []byte(strings.Repeat("x", MaxDebugBodySize+10)))
+
+	responses := capture.Responses()
+	if len(responses) != 2 {
+		t.Fatalf("Expected 2 captured responses, got %d", len(responses))
+	}
+
+	first := responses[0]
+	if first.Provider != "google" || first.Status != http.StatusOK || first.BodyBytes != 13 || first.Truncated {
+		t.Errorf("Unexpected captured response: %+v", first)
+	}
+	if strings.Contains(first.URL, "secret-key") || !strings.Contains(first.URL, "key=REDACTED") || !strings.Contains(first.URL, "q=golang") {
+		t.Errorf("Expected the API key to be redacted from the URL, got %s", first.URL)
+	}
+	if first.Headers["X-Subscription-Token"] != "REDACTED" || first.Headers["Set-Cookie"] != "REDACTED" || first.Headers["X-Ratelimit-Remaining"] != "99" {
+		t.Errorf("Expected only secret headers to be redacted, got %v", first.Headers)
+	}
+
+	wantDir := filepath.Join(home, ".mcp-devtools", "debug")
+	if filepath.Dir(first.BodyFile) != wantDir || !strings.HasSuffix(first.BodyFile, ".json") || !strings.Contains(filepath.Base(first.BodyFile), "-google-") {
+		t.Errorf("Expected a timestamped JSON file in %s, got %s", wantDir, first.BodyFile)
+	}
+	if body, err := os.ReadFile(first.BodyFile); err != nil || string(body) != `{"items": []}` {
+		t.Errorf("Expected the raw body to be saved, got %q (%v)", body, err)
+	}
+
+	second := responses[1]
+	info, err := os.Stat(second.BodyFile)
+	if err != nil || info.Size() != MaxDebugBodySize || !second.Truncated || second.BodyBytes != MaxDebugBodySize+10 {
+		t.Errorf("Expected the body to be capped at %d bytes, got %+v (%v)", MaxDebugBodySize, second, err)
+	}
+	if !strings.HasSuffix(second.BodyFile, ".html") {
+		t.Errorf("Expected an HTML file, got %s", second.BodyFile)
+	}
+}
+
+func TestCaptureResponse_WithoutCapture(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Providers always call CaptureResponse, so it must be a no-op for normal searches
+	CaptureResponse(context.Background(), newDebugTestLogger(), "brave", &http.Response{StatusCode: http.StatusOK}, []byte("{}"))
+
+	dir, _ := DebugDir()
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("Expected no debug directory without a capture, got %v", err)
+	}
+}
+
+func TestNewDebugCapture_PrunesOldFiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(SearchDebugRetentionEnvVar, "1h")
+
+	dir, _ := DebugDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	oldFile := filepath.Join(dir, "old.json")
+	newFile := filepath.Join(dir, "new.json")
+	for _, path := range []string{oldFile, newFile} {
+		if err := os.WriteFile(path, []byte("{}"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	twoHoursAgo := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(oldFile, twoHoursAgo, twoHoursAgo); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewDebugCapture(newDebugTestLogger()); err != nil {
+		t.Fatalf("Expected a debug capture, got error: %v", err)
+	}
+	if _, err := os.Stat(oldFile); !os.IsNotExist(err) {
+		t.Errorf("Expected the expired file to be removed, got %v", err)
+	}
+	if _, err := os.Stat(newFile); err != nil {
+		t.Errorf("Expected the recent file to be kept, got %v", err)
+	}
+}
+
+func TestDebugEnabled(t *testing.T) {
+	t.Setenv(SearchDebugEnvVar, "")
+	if DebugEnabled(map[string]any{}) || !DebugEnabled(map[string]any{"debug": true}) {
+		t.Error("Expected the debug argument to control capture")
+	}
+
+	t.Setenv(SearchDebugEnvVar, "true")
+	if !DebugEnabled(map[string]any{}) {
+		t.Errorf("Expected %s=true to enable capture", SearchDebugEnvVar)
+	}
+}
+
+func TestRedactURLError(t *testing.T) {
+	err := &url.Error{Op: "Get", URL: "https://www.googleapis.com/customsearch/v1?key=secret-key&q=golang", Err: errors.New("connection refused")}
+	redactedErr := RedactURLError(err)
+
+	if strings.Contains(redactedErr.Error(), "secret-key") || !strings.Contains(redactedErr.Error(), "connection refused") {
+		t.Errorf("Expected the API key to be redacted from the error, got %v", redactedErr)
+	}
+	if other := errors.New("other failure"); RedactURLError(other) != other {
+		t.Error("Expected errors without a URL to be returned unchanged")
+	}
+}
@@ -0,0 +1,153 @@
+package internetsearch
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// MaxSiteOperators caps how many include domains are added to a query as site: operators,
+// since long OR chains are truncated or rejected by search engines
+const MaxSiteOperators = 5
+
+// domainPattern matches a bare domain name such as "example.com" or "docs.example.co.uk"
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// DomainFilter restricts results to, or excludes results from, a set of domains.
+// A domain matches itself and all of its subdomains: "example.com" matches "example.com",
+// "www.example.com" and "docs.example.com", but not "notexample.com".
+type DomainFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// ParseDomainFilter reads and validates the optional include_domains and exclude_domains arguments.
+// Entries may be given as URLs or with a "www." or "*." prefix, and are normalised to bare domains.
+func ParseDomainFilter(args map[string]any) (DomainFilter, error) {
+	var filter DomainFilter
+	var err error
+	if filter.Include, err = parseDomains(args, "include_domains"); err != nil {
+		return DomainFilter{}, err
+	}
+	if filter.Exclude, err = parseDomains(args, "exclude_domains"); err != nil {
+		return DomainFilter{}, err
+	}
+	return filter, nil
+}
+
+// parseDomains normalises and validates a list of domains, dropping repeats
+func parseDomains(args map[string]any, key string) ([]string, error) {
+	var domains []string
+	for _, raw := range StringSliceArg(args, key) {
+		domain := normaliseDomain(raw)
+		if !domainPattern.MatchString(domain) {
+			return nil, fmt.Errorf("invalid domain %q in %s, expected a domain such as 'example.com'", raw, key)
+		}
+		if !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+	return domains, nil
+}
+
+// normaliseDomain reduces a domain or URL to a lower-case bare domain
+func normaliseDomain(raw string) string {
+	domain := strings.ToLower(strings.TrimSpace(raw))
+	if strings.Contains(domain, "://") {
+		if parsed, err := url.Parse(domain); err == nil {
+			domain = parsed.Hostname()
+		}
+	}
+	domain, _, _ = strings.Cut(domain, "/")
+	domain = strings.TrimPrefix(domain, "*.")
+	domain = strings.TrimPrefix(domain, "www.")
+	return strings.Trim(domain, ".")
+}
+
+// IsEmpty reports whether the filter has no domains
+func (f DomainFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Allows reports whether a result URL passes the filter
+func (f DomainFilter) Allows(rawURL string) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	parsedHost, ok := ParseHost(rawURL)
+	if !ok {
+		return len(f.Include) == 0
+	}
+	host := parsedHost.ASCII
+
+	for _, domain := range f.Exclude {
+		if DomainMatches(host, domain) {
+			return false
+		}
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, domain := range f.Include {
+		if DomainMatches(host, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply removes results that don't pass the filter, returning the kept results and how many were removed
+func (f DomainFilter) Apply(results []SearchResult) ([]SearchResult, int) {
+	if f.IsEmpty() {
+		return results, 0
+	}
+
+	// Answers and other results without a URL don't come from a domain, so only exclusions apply to them
+	excludeOnly := DomainFilter{Exclude: f.Exclude}
+	kept := make([]SearchResult, 0, len(results))
+	for _, result := range results {
+		filter := f
+		if result.URL == "" || result.Type == "answer" {
+			filter = excludeOnly
+		}
+		if filter.Allows(result.URL) {
+			kept = append(kept, result)
+		}
+	}
+	return kept, len(results) - len(kept)
+}
+
+// IncludeOperators appends OR-joined site: operators for the include domains, capped at MaxSiteOperators,
+// for providers that restrict domains through query syntax. Results should still be checked with Apply
+// as operators beyond the cap are dropped.
+func (f DomainFilter) IncludeOperators(query string) string {
+	if len(f.Include) == 0 {
+		return query
+	}
+
+	include := f.Include[:min(len(f.Include), MaxSiteOperators)]
+	sites := make([]string, 0, len(include))
+	for _, domain := range include {
+		sites = append(sites, "site:"+domain)
+	}
+	if len(sites) == 1 {
+		return query + " " + sites[0]
+	}
+	return query + " (" + strings.Join(sites, " OR ") + ")"
+}
+
+// ExcludeOperators appends a -site: operator for each exclude domain
+func (f DomainFilter) ExcludeOperators(query string) string {
+	for _, domain := range f.Exclude {
+		query += " -site:" + domain
+	}
+	return query
+}
+
+// DomainMatches reports whether host is domain or one of its subdomains
+func DomainMatches(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
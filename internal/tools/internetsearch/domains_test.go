@@ -0,0 +1,117 @@
+package internetsearch
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDomainFilter_Allows(t *testing.T) {
+	filter := DomainFilter{Include: []string{"example.com", "go.dev"}, Exclude: []string{"ads.example.com"}}
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: "https://example.com/page", want: true},
+		{url: "https://www.example.com/page", want: true},
+		{url: "https://docs.example.com/guide", want: true},
+		{url: "https://EXAMPLE.com./page", want: true},
+		{url: "https://pkg.go.dev/fmt", want: true},
+		{url: "https://notexample.com/", want: false},
+		{url: "https://example.com.evil.net/", want: false},
+		{url: "https://ads.example.com/banner", want: false},
+		{url: "https://eu.ads.example.com/banner", want: false},
+		{url: "https://golang.org/", want: false},
+		{url: "not a url", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := filter.Allows(tt.url); got != tt.want {
+			t.Errorf("Allows(%q) = %v, expected %v", tt.url, got, tt.want)
+		}
+	}
+
+	excludeOnly := DomainFilter{Exclude: []string{"pinterest.com"}}
+	if !excludeOnly.Allows("https://go.dev/") || !excludeOnly.Allows("not a url") {
+		t.Error("Expected an exclude-only filter to allow other URLs")
+	}
+	if excludeOnly.Allows("https://www.pinterest.com/pin/1") {
+		t.Error("Expected an exclude-only filter to drop excluded subdomains")
+	}
+}
+
+func TestDomainFilter_ApplyKeepsAnswers(t *testing.T) {
+	results := []SearchResult{
+		{Title: "Answer: golang generics", Description: "Generics were added in Go 1.18", Type: "answer"},
+		{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics"},
+		{Title: "Blog post", URL: "https://medium.com/generics"},
+		{Title: "No URL"},
+	}
+
+	kept, removed := DomainFilter{Include: []string{"go.dev"}}.Apply(results)
+	if removed != 1 || len(kept) != 3 || kept[0].Type != "answer" || kept[2].Title != "No URL" {
+		t.Errorf("Expected the answer and URL-less results to survive include_domains, got %d removed and %+v", removed, kept)
+	}
+
+	kept, removed = DomainFilter{Exclude: []string{"medium.com"}}.Apply(results)
+	if removed != 1 || len(kept) != 3 || kept[0].Type != "answer" {
+		t.Errorf("Expected only the excluded domain to be removed, got %d removed and %+v", removed, kept)
+	}
+}
+
+func TestParseDomainFilter(t *testing.T) {
+	filter, err := ParseDomainFilter(map[string]any{
+		"include_domains": []any{"https://www.Go.dev/doc", "*.example.com", "go.dev", " github.com "},
+		"exclude_domains": "Pinterest.com",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if want := []string{"go.dev", "example.com", "github.com"}; !slices.Equal(filter.Include, want) {
+		t.Errorf("Expected include %v, got %v", want, filter.Include)
+	}
+	if want := []string{"pinterest.com"}; !slices.Equal(filter.Exclude, want) {
+		t.Errorf("Expected exclude %v, got %v", want, filter.Exclude)
+	}
+
+	for _, invalid := range []string{"not a domain", "localhost", "exa_mple.com", "-example.com"} {
+		if _, err := ParseDomainFilter(map[string]any{"include_domains": []any{invalid}}); err == nil {
+			t.Errorf("Expected error for invalid domain %q", invalid)
+		}
+	}
+
+	empty, err := ParseDomainFilter(map[string]any{})
+	if err != nil || !empty.IsEmpty() {
+		t.Errorf("Expected an empty filter without arguments, got %v, %v", empty, err)
+	}
+}
+
+func TestDomainFilter_Operators(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter DomainFilter
+		want   string
+	}{
+		{name: "no domains", filter: DomainFilter{}, want: "golang"},
+		{name: "single include", filter: DomainFilter{Include: []string{"go.dev"}}, want: "golang site:go.dev"},
+		{name: "multiple includes", filter: DomainFilter{Include: []string{"go.dev", "github.com"}}, want: "golang (site:go.dev OR site:github.com)"},
+		{
+			name:   "includes are capped",
+			filter: DomainFilter{Include: []string{"a.com", "b.com", "c.com", "d.com", "e.com", "f.com"}},
+			want:   "golang (site:a.com OR site:b.com OR site:c.com OR site:d.com OR site:e.com)",
+		},
+		{
+			name:   "include and exclude",
+			filter: DomainFilter{Include: []string{"go.dev"}, Exclude: []string{"pkg.go.dev", "tour.go.dev"}},
+			want:   "golang site:go.dev -site:pkg.go.dev -site:tour.go.dev",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.ExcludeOperators(tt.filter.IncludeOperators("golang")); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package duckduckgo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// duckDuckGoBaseURL serves the vqd token page and the JSON verticals (news.js, v.js)
+	duckDuckGoBaseURL = "https://duckduckgo.com"
+
+	// duckDuckGoUserAgent is sent with every request to appear more like a browser
+	duckDuckGoUserAgent = "Mozilla/5.0 (compatible; MCP-DevTools/1.0)"
+
+	// duckDuckGoDefaultRegion is DuckDuckGo's "no region" locale
+	duckDuckGoDefaultRegion = "wt-wt"
+
+	// duckDuckGoMaxWebPages caps how many HTML result pages are fetched to meet the count or make up for domain filtering
+	duckDuckGoMaxWebPages = 3
+
+	// Web search endpoints, the lite one is used when the HTML endpoint serves a degraded or blocked page
+	endpointHTML = "html"
+	endpointLite = "lite"
+)
+
+// webEndpoint is a DuckDuckGo web search interface that accepts the search form
+type webEndpoint struct {
+	host string
+	url  string
+	// searchPageSelector matches markup every genuine results page has, including one with no results
+	searchPageSelector string
+}
+
+var webEndpoints = map[string]webEndpoint{
+	endpointHTML: {host: "html.duckduckgo.com", url: "https://html.duckduckgo.com/html", searchPageSelector: ".no-results, .results, #links"},
+	endpointLite: {host: "lite.duckduckgo.com", url: "https://lite.duckduckgo.com/lite/", searchPageSelector: `form[action*="/lite"] input[name="q"]`},
+}
+
+// anomalySelector matches the modal and form of DuckDuckGo's bot challenge ("anomaly") page
+const anomalySelector = `.anomaly-modal__modal, .anomaly-modal__mask, form[action*="anomaly.js"]`
+
+// nextPageSelector matches the Next button of the form the HTML and lite results pages use to request the following page
+const nextPageSelector = `.nav-link input[type="submit"][value="Next"], input.navbutton[type="submit"][value^="Next"]`
+
+// vqdPattern matches the vqd token embedded in the DuckDuckGo search page,
+// which appears as vqd="4-123...", vqd='4-123...' or vqd=4-123...&
+var vqdPattern = regexp.MustCompile(`vqd=["']?([0-9-]+)`)
+
+// fetchVQD retrieves the per-query vqd token required by DuckDuckGo's JSON verticals
+func (p *DuckDuckGoProvider) fetchVQD(ctx context.Context, logger *logrus.Logger, query string) (string, error) {
+	params := url.Values{}
+	params.Set("q", query)
+
+	body, err := p.get(ctx, logger, "/", params, "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch vqd token: %w", err)
+	}
+
+	matches := vqdPattern.FindSubmatch(body)
+	if len(matches) < 2 {
+		if doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body)); err == nil && isChallengePage(doc) {
+			return "", blockedError("returned a bot challenge page")
+		}
+		return "", fmt.Errorf("%w: failed to fetch vqd token: token not found in DuckDuckGo response", internetsearch.ErrParse)
+	}
+
+	return string(matches[1]), nil
+}
+
+// fetchVertical calls a DuckDuckGo JSON vertical endpoint (e.g. /news.js) for the query,
+// obtaining the vqd token first
+func (p *DuckDuckGoProvider) fetchVertical(ctx context.Context, logger *logrus.Logger, endpoint, query string, params url.Values) ([]byte, error) {
+	vqd, err := p.fetchVQD(ctx, logger, query)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Set("q", query)
+	params.Set("vqd", vqd)
+	params.Set("o", "json")
+	if params.Get("l") == "" {
+		params.Set("l", duckDuckGoDefaultRegion)
+	}
+
+	return p.get(ctx, logger, endpoint, params, "application/json, text/javascript, */*; q=0.01")
+}
+
+// get performs a GET request against the DuckDuckGo base URL with security checks and rate limit detection
+func (p *DuckDuckGoProvider) get(ctx context.Context, logger *logrus.Logger, endpoint string, params url.Values, accept string) ([]byte, error) {
+	reqURL, err := url.Parse(p.baseURL + endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+	reqURL.RawQuery = params.Encode()
+
+	// Security check: verify domain access before making request
+	if err := security.CheckDomainAccess(reqURL.Hostname()); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", duckDuckGoUserAgent)
+	req.Header.Set("Accept", accept)
+	req.Header.Set("Accept-Language", "en-GB,en;q=0.9")
+	req.Header.Set("Referer", p.baseURL+"/")
+
+	logger.WithField("url", reqURL.String()).Debug("Making DuckDuckGo request")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("Failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response: %w", internetsearch.ErrNetwork, err))
+	}
+	internetsearch.CaptureResponse(ctx, logger, "duckduckgo", resp, body)
+
+	if err := checkStatus(resp); err != nil {
+		return nil, err
+	}
+
+	// Security analysis: check response content for threats
+	if security.IsEnabled() {
+		source := security.SourceContext{
+			Tool:        "internet_search",
+			Domain:      reqURL.Hostname(),
+			ContentType: resp.Header.Get("Content-Type"),
+			URL:         reqURL.String(),
+		}
+		if secResult, err := security.AnalyseContent(string(body), source); err == nil {
+			switch secResult.Action {
+			case security.ActionBlock:
+				return nil, security.FormatSecurityBlockErrorFromResult(secResult)
+			case security.ActionWarn:
+				logger.Warnf("Security warning [ID: %s]: %s", secResult.ID, secResult.Message)
+			}
+		}
+	}
+
+	return body, nil
+}
+
+// checkStatus converts a non-200 DuckDuckGo response into an error, marking rate limits
+// and server errors as retryable and treating a 403 as a block
+func checkStatus(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	// 202 is DuckDuckGo's rate limit response
+	case resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusTooManyRequests:
+		return &internetsearch.RateLimitError{
+			Provider:   "duckduckgo",
+			RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	case resp.StatusCode == http.StatusForbidden:
+		return blockedError("rejected the request with status 403")
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return internetsearch.Retryable(fmt.Errorf("DuckDuckGo search error: status %d", resp.StatusCode))
+	default:
+		return fmt.Errorf("DuckDuckGo search error: status %d", resp.StatusCode)
+	}
+}
+
+// isChallengePage reports whether a DuckDuckGo HTML page is a bot challenge rather than a search page
+func isChallengePage(doc *goquery.Document) bool {
+	return doc.Find(anomalySelector).Length() > 0 || strings.Contains(doc.Text(), "bots use DuckDuckGo too")
+}
+
+// hasNextPage reports whether a DuckDuckGo results page links to a further page of results
+func hasNextPage(doc *goquery.Document) bool {
+	return doc.Find(nextPageSelector).Length() > 0
+}
+
+// unusablePageReason explains why a page without results isn't a genuine no-results page from the endpoint,
+// returning "" when it is one
+func unusablePageReason(endpoint string, doc *goquery.Document) string {
+	switch {
+	case isChallengePage(doc):
+		return "returned a bot challenge page"
+	case doc.Find(webEndpoints[endpoint].searchPageSelector).Length() == 0:
+		return "returned a page without search results"
+	default:
+		return ""
+	}
+}
+
+// maxRedirectDecodes bounds how many times a uddg redirect target is unescaped, as some links encode it twice
+const maxRedirectDecodes = 3
+
+// resolveResultURL turns a result link into an absolute http(s) URL. Protocol-relative and relative links are
+// resolved against the page URL, and DuckDuckGo click-tracking redirects (/l/?uddg=...) are replaced by their
+// fully decoded destination.
+func resolveResultURL(base *url.URL, link string) (string, error) {
+	ref, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return "", err
+	}
+	resolved := base.ResolveReference(ref)
+
+	if isRedirectURL(resolved) {
+		target := resolved.Query().Get("uddg")
+		for i := 0; i < maxRedirectDecodes && !strings.Contains(target, "://") && strings.Contains(target, "%"); i++ {
+			decoded, err := url.QueryUnescape(target)
+			if err != nil {
+				break
+			}
+			target = decoded
+		}
+
+		if resolved, err = url.Parse(strings.TrimSpace(target)); err != nil {
+			return "", err
+		}
+		// The destination of a redirect has nothing to resolve against, apart from a missing scheme
+		if resolved.Scheme == "" && resolved.Host != "" {
+			resolved.Scheme = "https"
+		}
+	}
+
+	if resolved.Scheme != "http" && resolved.Scheme != "https" {
+		return "", fmt.Errorf("unsupported result URL scheme %q", resolved.Scheme)
+	}
+	if resolved.Host == "" {
+		return "", fmt.Errorf("result URL has no host")
+	}
+	return resolved.String(), nil
+}
+
+// isRedirectURL reports whether a resolved link is a DuckDuckGo click-tracking redirect
+func isRedirectURL(link *url.URL) bool {
+	host := strings.ToLower(link.Hostname())
+	return (host == "duckduckgo.com" || strings.HasSuffix(host, ".duckduckgo.com")) &&
+		strings.TrimSuffix(link.Path, "/") == "/l" && link.Query().Has("uddg")
+}
+
+// isAdLink reports whether a result link goes through DuckDuckGo's ad redirect
+func isAdLink(link string) bool {
+	return strings.Contains(link, "y.js")
+}
+
+// blockedError wraps ErrProviderBlocked with guidance, as retrying straight away only prolongs the block
+func blockedError(reason string) error {
+	return fmt.Errorf("%w: DuckDuckGo %s, back off for a few minutes or switch to another provider", internetsearch.ErrProviderBlocked, reason)
+}
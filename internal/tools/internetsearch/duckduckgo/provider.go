@@ -2,11 +2,14 @@ package duckduckgo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,16 +19,32 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// HTTPDoer is the minimal HTTP client the provider sends its requests through
+type HTTPDoer = internetsearch.HTTPClientInterface
+
 // DuckDuckGoProvider implements the unified SearchProvider interface
 type DuckDuckGoProvider struct {
-	client internetsearch.HTTPClientInterface
+	client      HTTPDoer
+	baseURL     string
+	retryPolicy internetsearch.RetryPolicy
 }
 
 // NewDuckDuckGoProvider creates a new DuckDuckGo search provider with rate limiting
 // DuckDuckGo doesn't require an API key, so it's always available
 func NewDuckDuckGoProvider() *DuckDuckGoProvider {
+	return NewDuckDuckGoProviderWithClient(nil)
+}
+
+// NewDuckDuckGoProviderWithClient creates a DuckDuckGo search provider that sends every request through
+// client, such as a fake serving recorded pages in tests. A nil client uses the rate limited default.
+func NewDuckDuckGoProviderWithClient(client HTTPDoer) *DuckDuckGoProvider {
+	if client == nil {
+		client = internetsearch.NewRateLimitedHTTPClient()
+	}
 	return &DuckDuckGoProvider{
-		client: internetsearch.NewRateLimitedHTTPClient(),
+		client:      client,
+		baseURL:     duckDuckGoBaseURL,
+		retryPolicy: internetsearch.DefaultRetryPolicy(),
 	}
 }
 
@@ -42,9 +61,16 @@ func (p *DuckDuckGoProvider) IsAvailable() bool {
 
 // GetSupportedTypes returns the search types this provider supports
 func (p *DuckDuckGoProvider) GetSupportedTypes() []string {
-	// DuckDuckGo HTML interface primarily supports internet search
-	// We'll map all types to internet search for simplicity
-	return []string{"web"}
+	// Internet search uses the HTML interface, news and video use the news.js and v.js verticals
+	return []string{"web", "news", "video"}
+}
+
+// Describe returns how the provider is configured, for provider listings
+func (p *DuckDuckGoProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "DuckDuckGo HTML, news and video search, always available without an API key",
+		OptionalEnvVars: []string{"INTERNET_SEARCH_RETRY_ATTEMPTS"},
+	}
 }
 
 // Search executes a search using the DuckDuckGo provider
@@ -57,36 +83,224 @@ func (p *DuckDuckGoProvider) Search(ctx context.Context, logger *logrus.Logger,
 		"query":    query,
 	}).Debug("DuckDuckGo search parameters")
 
-	// For DuckDuckGo, all search types are handled as internet search
-	return p.executeInternetSearch(ctx, logger, args)
+	opts, err := p.parseSearchOptions(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var execute func() (*internetsearch.SearchResponse, error)
+	switch searchType {
+	case "web":
+		execute = func() (*internetsearch.SearchResponse, error) {
+			return p.executeInternetSearch(ctx, logger, args, opts)
+		}
+	case "news":
+		execute = func() (*internetsearch.SearchResponse, error) { return p.executeNewsSearch(ctx, logger, args, opts) }
+	case "video":
+		execute = func() (*internetsearch.SearchResponse, error) { return p.executeVideoSearch(ctx, logger, args, opts) }
+	default:
+		return nil, fmt.Errorf("unsupported search type for DuckDuckGo: %s", searchType)
+	}
+
+	// Each attempt rebuilds its requests (and vqd token) from scratch, so no request body is reused
+	var response *internetsearch.SearchResponse
+	stats, err := internetsearch.Retry(ctx, logger, p.retryPolicy, func() error {
+		var attemptErr error
+		response, attemptErr = execute()
+		return attemptErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.Apply(response)
+
+	if opts.region != nil {
+		response.Region = opts.region.Code
+	}
+	// The video vertical's publishedAfter filter has no year option
+	response.ApplyTimeRange(opts.timeRange, searchType != "video" || opts.timeRange != internetsearch.TimeRangeYear)
+	return response, nil
 }
 
-// executeInternetSearch handles internet search execution
-func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
-	query := args["query"].(string)
+// searchOptions holds the optional arguments shared by all DuckDuckGo search types
+type searchOptions struct {
+	count      int
+	region     *internetsearch.Region
+	safeSearch string
+	timeRange  string
+	domains    internetsearch.DomainFilter
+}
+
+// timeRangeValues maps time ranges to DuckDuckGo's df values
+var timeRangeValues = map[string]string{
+	internetsearch.TimeRangeDay:   "d",
+	internetsearch.TimeRangeWeek:  "w",
+	internetsearch.TimeRangeMonth: "m",
+	internetsearch.TimeRangeYear:  "y",
+}
 
-	// Parse optional parameters
-	count := 10
+// safeSearchValues maps safe search levels to DuckDuckGo's kp/p values
+var safeSearchValues = map[string]string{
+	internetsearch.SafeSearchOff:      "-2",
+	internetsearch.SafeSearchModerate: "-1",
+	internetsearch.SafeSearchStrict:   "1",
+}
+
+// parseSearchOptions reads and validates the optional arguments shared by all search types
+func (p *DuckDuckGoProvider) parseSearchOptions(args map[string]any) (searchOptions, error) {
+	opts := searchOptions{count: 10}
 	if countRaw, ok := args["count"].(float64); ok {
-		count = int(countRaw)
-		if count < 1 || count > 50 {
-			return nil, fmt.Errorf("count must be between 1 and 50 for DuckDuckGo search, got %d", count)
+		opts.count = int(countRaw)
+		if opts.count < 1 || opts.count > 50 {
+			return opts, fmt.Errorf("count must be between 1 and 50 for DuckDuckGo search, got %d", opts.count)
 		}
 	}
 
-	// Security check: verify domain access before making request
-	if err := security.CheckDomainAccess("html.duckduckgo.com"); err != nil {
+	region, err := internetsearch.ParseRegion(args)
+	if err != nil {
+		return opts, err
+	}
+	opts.region = region
+
+	safeSearch, err := internetsearch.ParseSafeSearch(args)
+	if err != nil {
+		return opts, err
+	}
+	opts.safeSearch = safeSearch
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return opts, err
+	}
+	opts.timeRange = timeRange
+
+	domains, err := internetsearch.ParseDomainFilter(args)
+	if err != nil {
+		return opts, err
+	}
+	opts.domains = domains
+
+	return opts, nil
+}
+
+// regionCode returns the DuckDuckGo region code, or the fallback when no region was requested
+func (o searchOptions) regionCode(fallback string) string {
+	if o.region == nil {
+		return fallback
+	}
+	return o.region.Code
+}
+
+// executeInternetSearch handles internet search execution. A results page holds fewer results than the
+// count allows, so further pages are fetched until the count is met or DuckDuckGo runs out of results.
+// Include domains are added to the query as site: operators and all domain filters are applied to the
+// parsed results. When the HTML endpoint serves a page that is neither results nor a genuine no-results
+// page, the search falls back to the lite endpoint, which then serves any further pages.
+func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts searchOptions) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	var results []internetsearch.SearchResult
+	dedup := internetsearch.NewURLDeduplicator()
+	filtered := 0
+	offset := 0
+	endpoint := endpointHTML
+
+	stats, err := internetsearch.FetchPages(logger, "duckduckgo", opts.count, duckDuckGoMaxWebPages, func(page, remaining int) (int, bool, error) {
+		// Create form data for POST request, encoded into a fresh reader on every attempt
+		formData := url.Values{}
+		formData.Set("q", opts.domains.IncludeOperators(query))
+		formData.Set("b", "")
+		formData.Set("kl", opts.regionCode(""))
+		formData.Set("kp", safeSearchValues[opts.safeSearch])
+		if opts.timeRange != "" {
+			formData.Set("df", timeRangeValues[opts.timeRange])
+		}
+		if offset > 0 {
+			formData.Set("s", strconv.Itoa(offset))
+			formData.Set("dc", strconv.Itoa(offset+1))
+		}
+
+		doc, err := p.fetchWebPage(ctx, logger, endpoint, formData)
+		if err != nil {
+			return 0, false, err
+		}
+
+		pageResults := p.parsePage(logger, endpoint, doc)
+		if len(pageResults) == 0 && page == 0 {
+			reason := unusablePageReason(endpoint, doc)
+			if reason != "" && endpoint == endpointHTML {
+				logger.WithField("reason", reason).Warn("DuckDuckGo HTML endpoint served an unusable page, falling back to the lite endpoint")
+				endpoint = endpointLite
+				if doc, err = p.fetchWebPage(ctx, logger, endpoint, formData); err != nil {
+					return 0, false, err
+				}
+				if pageResults = p.parsePage(logger, endpoint, doc); len(pageResults) == 0 {
+					reason = unusablePageReason(endpoint, doc)
+				}
+			}
+			if len(pageResults) == 0 && reason != "" {
+				return 0, false, blockedError(reason)
+			}
+		}
+		if len(pageResults) == 0 {
+			return 0, false, nil
+		}
+		offset += len(pageResults)
+
+		// Drop repeats of a page already listed and results outside the requested domains
+		added, unseen := 0, 0
+		for _, result := range pageResults {
+			if added >= remaining {
+				break
+			}
+			if dedup.Duplicate(result.URL) {
+				continue
+			}
+			unseen++
+			if !opts.domains.Allows(result.URL) {
+				filtered++
+				continue
+			}
+			result.Metadata["position"] = len(results) + 1
+			results = append(results, result)
+			added++
+		}
+
+		// A page of nothing but repeats means DuckDuckGo has no further results, whatever its navigation says
+		return added, unseen > 0 && hasNextPage(doc), nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Create form data for POST request
-	formData := url.Values{}
-	formData.Set("q", query)
-	formData.Set("b", "")
-	formData.Set("kl", "")
+	if len(results) == 0 {
+		emptyResponse := p.createEmptyResponse()
+		recordDomainFiltered(emptyResponse, filtered)
+		stats.Apply(emptyResponse)
+		emptyResponse.SetMetadata("endpoint", endpoint)
+		return emptyResponse, nil
+	}
+
+	searchResponse := p.createSuccessResponse(query, results, logger)
+	searchResponse.DuplicatesRemoved = dedup.Removed()
+	recordDomainFiltered(searchResponse, filtered)
+	stats.Apply(searchResponse)
+	searchResponse.SetMetadata("endpoint", endpoint)
+	return searchResponse, nil
+}
+
+// fetchWebPage posts a search form to a DuckDuckGo web search endpoint and parses the returned page
+func (p *DuckDuckGoProvider) fetchWebPage(ctx context.Context, logger *logrus.Logger, endpoint string, formData url.Values) (*goquery.Document, error) {
+	target := webEndpoints[endpoint]
+
+	// Security check: verify domain access before making request
+	if err := security.CheckDomainAccess(target.host); err != nil {
+		return nil, err
+	}
 
 	// Create POST request with proper headers
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://html.duckduckgo.com/html", strings.NewReader(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", target.url, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -100,7 +314,7 @@ func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *
 	// Execute request with rate limiting
 	resp, err := p.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("search request failed: %w", err)
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
 	}
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
@@ -111,25 +325,21 @@ func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *
 	// Read response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response: %w", internetsearch.ErrNetwork, err))
 	}
+	internetsearch.CaptureResponse(ctx, logger, "duckduckgo", resp, body)
 
-	// Check for rate limiting (202 is DuckDuckGo's rate limit response)
-	if resp.StatusCode == http.StatusAccepted {
-		return nil, fmt.Errorf("rate limit exceeded: DuckDuckGo, please wait before retrying")
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("DuckDuckGo search error: status %d", resp.StatusCode)
+	if err := checkStatus(resp); err != nil {
+		return nil, err
 	}
 
 	// Security analysis: check response content for threats
 	if security.IsEnabled() {
 		source := security.SourceContext{
 			Tool:        "internet_search",
-			Domain:      "html.duckduckgo.com",
+			Domain:      target.host,
 			ContentType: "text/html",
-			URL:         "https://html.duckduckgo.com/html",
+			URL:         target.url,
 		}
 		if secResult, err := security.AnalyseContent(string(body), source); err == nil {
 			switch secResult.Action {
@@ -148,16 +358,48 @@ func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *
 	// Parse HTML response using goquery
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse HTML response: %w", err)
+		return nil, fmt.Errorf("%w: failed to parse HTML response: %w", internetsearch.ErrParse, err)
+	}
+	// Result links are resolved against the URL that served the page, after any redirects
+	if resp.Request != nil {
+		doc.Url = resp.Request.URL
 	}
 
-	// Extract search results
-	var results []internetsearch.SearchResult
-	doc.Find(".result").Each(func(i int, s *goquery.Selection) {
-		if len(results) >= count {
-			return
+	return doc, nil
+}
+
+// parsePage extracts the organic results from a results page served by the endpoint, resolving their links
+// against the page URL and dropping any that don't lead to a valid web address
+func (p *DuckDuckGoProvider) parsePage(logger *logrus.Logger, endpoint string, doc *goquery.Document) []internetsearch.SearchResult {
+	var parsed []internetsearch.SearchResult
+	if endpoint == endpointLite {
+		parsed = p.parseLiteResults(doc)
+	} else {
+		parsed = p.parseWebResults(doc)
+	}
+
+	base := doc.Url
+	if base == nil {
+		base, _ = url.Parse(webEndpoints[endpoint].url)
+	}
+
+	results := parsed[:0]
+	for _, result := range parsed {
+		resolved, err := resolveResultURL(base, result.URL)
+		if err != nil {
+			logger.WithError(err).WithField("url", result.URL).Debug("Dropping DuckDuckGo result with an invalid URL")
+			continue
 		}
+		result.URL = resolved
+		results = append(results, result)
+	}
+	return results
+}
 
+// parseWebResults extracts the organic results from a DuckDuckGo HTML results page, skipping ads
+func (p *DuckDuckGoProvider) parseWebResults(doc *goquery.Document) []internetsearch.SearchResult {
+	var results []internetsearch.SearchResult
+	doc.Find(".result").Each(func(i int, s *goquery.Selection) {
 		// Extract title and link
 		titleElem := s.Find(".result__title a").First()
 		if titleElem.Length() == 0 {
@@ -171,21 +413,10 @@ func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *
 		}
 
 		// Skip ad results
-		if strings.Contains(link, "y.js") {
+		if isAdLink(link) {
 			return
 		}
 
-		// Clean up DuckDuckGo redirect URLs
-		if strings.HasPrefix(link, "//duckduckgo.com/l/?uddg=") {
-			parts := strings.Split(link, "uddg=")
-			if len(parts) > 1 {
-				urlPart := strings.Split(parts[1], "&")[0]
-				if decodedURL, err := url.QueryUnescape(urlPart); err == nil {
-					link = decodedURL
-				}
-			}
-		}
-
 		// Extract snippet
 		snippet := ""
 		snippetElem := s.Find(".result__snippet").First()
@@ -193,23 +424,275 @@ func (p *DuckDuckGoProvider) executeInternetSearch(ctx context.Context, logger *
 			snippet = strings.TrimSpace(snippetElem.Text())
 		}
 
+		results = append(results, p.newWebResult(title, link, snippet))
+	})
+	return results
+}
+
+// parseLiteResults extracts the organic results from a DuckDuckGo lite results page, where each result is
+// a table row holding the link followed by a row holding its snippet
+func (p *DuckDuckGoProvider) parseLiteResults(doc *goquery.Document) []internetsearch.SearchResult {
+	var results []internetsearch.SearchResult
+	doc.Find("a.result-link").Each(func(i int, s *goquery.Selection) {
+		title := strings.TrimSpace(s.Text())
+		link, exists := s.Attr("href")
+		if !exists || title == "" {
+			return
+		}
+
+		// Skip ad results, which the lite page marks on their rows
+		row := s.Closest("tr")
+		if isAdLink(link) || row.HasClass("result-sponsored") {
+			return
+		}
+
+		snippet := strings.TrimSpace(row.Next().Find(".result-snippet").First().Text())
+		results = append(results, p.newWebResult(title, link, snippet))
+	})
+	return results
+}
+
+// newWebResult builds a result from the title, link and snippet text of either results page
+func (p *DuckDuckGoProvider) newWebResult(title, link, snippet string) internetsearch.SearchResult {
+	metadata := make(map[string]any)
+	metadata["provider"] = "duckduckgo"
+
+	return internetsearch.SearchResult{
+		Title:       p.cleanText(title),
+		URL:         link,
+		Description: p.cleanText(snippet),
+		Metadata:    metadata,
+	}
+}
+
+// recordDomainFiltered notes in the response metadata how many results the domain filter removed
+func recordDomainFiltered(response *internetsearch.SearchResponse, filtered int) {
+	if filtered > 0 {
+		response.SetMetadata("domain_filtered", filtered)
+	}
+}
+
+// executeNewsSearch handles news search execution via the news.js vertical
+func (p *DuckDuckGoProvider) executeNewsSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts searchOptions) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	params := url.Values{}
+	params.Set("noamp", "1")
+	params.Set("l", opts.regionCode(duckDuckGoDefaultRegion))
+	params.Set("p", safeSearchValues[opts.safeSearch])
+	if opts.timeRange != "" {
+		params.Set("df", timeRangeValues[opts.timeRange])
+	}
+
+	body, err := p.fetchVertical(ctx, logger, "/news.js", opts.domains.IncludeOperators(query), params)
+	if err != nil {
+		return nil, fmt.Errorf("news search failed: %w", err)
+	}
+
+	var response DuckDuckGoNewsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse news response: %w", internetsearch.ErrParse, err)
+	}
+
+	now := time.Now()
+	var results []internetsearch.SearchResult
+	dedup := internetsearch.NewURLDeduplicator()
+	filtered := 0
+	for _, article := range response.Results {
+		if len(results) >= opts.count {
+			break
+		}
+		if article.URL == "" || article.Title == "" || dedup.Duplicate(article.URL) {
+			continue
+		}
+		if !opts.domains.Allows(article.URL) {
+			filtered++
+			continue
+		}
+
 		metadata := make(map[string]any)
 		metadata["provider"] = "duckduckgo"
 		metadata["position"] = len(results) + 1
+		if article.Source != "" {
+			metadata["source"] = article.Source
+		}
+		if !article.Date.IsZero() {
+			metadata["published"] = article.Date.Format(time.RFC3339)
+		}
+		if article.RelativeTime != "" {
+			metadata["age"] = article.RelativeTime
+		} else if !article.Date.IsZero() {
+			metadata["age"] = relativeAge(article.Date.Time, now)
+		}
+		if article.Image != "" {
+			metadata["image"] = article.Image
+		}
 
 		results = append(results, internetsearch.SearchResult{
-			Title:       p.cleanText(title),
-			URL:         link,
-			Description: p.cleanText(snippet),
+			Title:       p.cleanText(html.UnescapeString(article.Title)),
+			URL:         article.URL,
+			Description: p.cleanText(html.UnescapeString(article.Excerpt)),
 			Metadata:    metadata,
 		})
-	})
+	}
+
+	if len(results) == 0 {
+		emptyResponse := p.createEmptyResponse()
+		recordDomainFiltered(emptyResponse, filtered)
+		return emptyResponse, nil
+	}
+
+	searchResponse := p.createSuccessResponse(query, results, logger)
+	searchResponse.DuplicatesRemoved = dedup.Removed()
+	recordDomainFiltered(searchResponse, filtered)
+	return searchResponse, nil
+}
+
+// executeVideoSearch handles video search execution via the v.js vertical
+func (p *DuckDuckGoProvider) executeVideoSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts searchOptions) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	params := url.Values{}
+	params.Set("l", opts.regionCode(duckDuckGoDefaultRegion))
+	params.Set("p", safeSearchValues[opts.safeSearch])
+	if opts.timeRange != "" && opts.timeRange != internetsearch.TimeRangeYear {
+		params.Set("f", "publishedAfter:"+timeRangeValues[opts.timeRange])
+	}
+
+	body, err := p.fetchVertical(ctx, logger, "/v.js", opts.domains.IncludeOperators(query), params)
+	if err != nil {
+		return nil, fmt.Errorf("video search failed: %w", err)
+	}
+
+	var response DuckDuckGoVideoResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse video response: %w", internetsearch.ErrParse, err)
+	}
+
+	var results []internetsearch.SearchResult
+	dedup := internetsearch.NewURLDeduplicator()
+	filtered := 0
+	for _, video := range response.Results {
+		if len(results) >= opts.count {
+			break
+		}
+		if video.Content == "" || video.Title == "" || dedup.Duplicate(video.Content) {
+			continue
+		}
+		if !opts.domains.Allows(video.Content) {
+			filtered++
+			continue
+		}
+
+		metadata := make(map[string]any)
+		metadata["provider"] = "duckduckgo"
+		metadata["position"] = len(results) + 1
+		if video.EmbedURL != "" {
+			metadata["embed_url"] = video.EmbedURL
+		}
+		if seconds, ok := parseDuration(video.Duration); ok {
+			metadata["duration"] = seconds
+		}
+		if video.Statistics != nil && video.Statistics.ViewCount != nil {
+			metadata["views"] = *video.Statistics.ViewCount
+		}
+		if video.Uploader != "" {
+			metadata["uploader"] = video.Uploader
+		}
+		if video.Publisher != "" {
+			metadata["publisher"] = video.Publisher
+		}
+		if !video.Published.IsZero() {
+			metadata["published"] = video.Published.Format(time.RFC3339)
+		}
+		if video.Images != nil {
+			switch {
+			case video.Images.Medium != "":
+				metadata["thumbnail"] = video.Images.Medium
+			case video.Images.Large != "":
+				metadata["thumbnail"] = video.Images.Large
+			case video.Images.Small != "":
+				metadata["thumbnail"] = video.Images.Small
+			}
+		}
+
+		results = append(results, internetsearch.SearchResult{
+			Title:       p.cleanText(html.UnescapeString(video.Title)),
+			URL:         video.Content,
+			Description: p.cleanText(html.UnescapeString(video.Description)),
+			Metadata:    metadata,
+		})
+	}
 
 	if len(results) == 0 {
-		return p.createEmptyResponse(), nil
+		emptyResponse := p.createEmptyResponse()
+		recordDomainFiltered(emptyResponse, filtered)
+		return emptyResponse, nil
+	}
+
+	searchResponse := p.createSuccessResponse(query, results, logger)
+	searchResponse.DuplicatesRemoved = dedup.Removed()
+	recordDomainFiltered(searchResponse, filtered)
+	return searchResponse, nil
+}
+
+// parseDuration converts a video duration such as "1:02:03", "4:05", "59" or "PT1H2M3S" to seconds
+func parseDuration(duration string) (int, bool) {
+	duration = strings.TrimSpace(duration)
+	if duration == "" {
+		return 0, false
+	}
+
+	if strings.HasPrefix(strings.ToUpper(duration), "PT") {
+		parsed, err := time.ParseDuration(strings.ToLower(duration[2:]))
+		if err != nil {
+			return 0, false
+		}
+		return int(parsed.Seconds()), true
 	}
 
-	return p.createSuccessResponse(query, results, logger), nil
+	parts := strings.Split(duration, ":")
+	if len(parts) > 3 {
+		return 0, false
+	}
+
+	seconds := 0
+	for _, part := range parts {
+		value, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || value < 0 {
+			return 0, false
+		}
+		seconds = seconds*60 + value
+	}
+	return seconds, true
+}
+
+// relativeAge describes how long before now a time was, e.g. "3 hours ago"
+func relativeAge(published, now time.Time) string {
+	elapsed := now.Sub(published)
+	if elapsed < time.Minute {
+		return "just now"
+	}
+
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s ago", unit)
+		}
+		return fmt.Sprintf("%d %ss ago", n, unit)
+	}
+
+	switch {
+	case elapsed < time.Hour:
+		return plural(int(elapsed/time.Minute), "minute")
+	case elapsed < 24*time.Hour:
+		return plural(int(elapsed/time.Hour), "hour")
+	case elapsed < 30*24*time.Hour:
+		return plural(int(elapsed/(24*time.Hour)), "day")
+	case elapsed < 365*24*time.Hour:
+		return plural(int(elapsed/(30*24*time.Hour)), "month")
+	default:
+		return plural(int(elapsed/(365*24*time.Hour)), "year")
+	}
 }
 
 // cleanText removes extra whitespace and cleans up text
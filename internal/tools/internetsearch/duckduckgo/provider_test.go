@@ -0,0 +1,1194 @@
+package duckduckgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const testVQD = "4-123456789012345678901234567890"
+
+func newTestProvider(server *httptest.Server) *DuckDuckGoProvider {
+	return &DuckDuckGoProvider{
+		client:  server.Client(),
+		baseURL: server.URL,
+	}
+}
+
+// verticalHandler serves the vqd token page and the given fixture for a JSON vertical endpoint
+func verticalHandler(t *testing.T, endpoint, fixture string, received *http.Request) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(`<html><script>var x = {vqd="` + testVQD + `"};</script></html>`))
+		case endpoint:
+			if received != nil {
+				*received = *r
+			}
+			data, err := os.ReadFile(filepath.Join("testdata", fixture))
+			if err != nil {
+				t.Errorf("Failed to read fixture %s: %v", fixture, err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(data)
+		default:
+			t.Errorf("Unexpected request path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestDuckDuckGoProvider_NewsFresh(t *testing.T) {
+	var received http.Request
+	server := httptest.NewServer(verticalHandler(t, "/news.js", "news_fresh.json", &received))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang release"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if got := received.URL.Query().Get("vqd"); got != testVQD {
+		t.Errorf("Expected vqd token %q to be sent, got %q", testVQD, got)
+	}
+	if got := received.URL.Query().Get("q"); got != "golang release" {
+		t.Errorf("Expected query to be sent, got %q", got)
+	}
+
+	if len(response.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(response.Results))
+	}
+
+	first := response.Results[0]
+	if first.Title != "Go 1.25.3 is released" || first.URL != "https://go.dev/blog/go1.25.3" {
+		t.Errorf("Unexpected first result: %+v", first)
+	}
+	if !strings.Contains(first.Description, "<code>net/http</code>") {
+		t.Errorf("Expected HTML entities to be unescaped, got %q", first.Description)
+	}
+	if first.Metadata["published"] != "2025-10-14T05:00:00Z" {
+		t.Errorf("Expected RFC3339 published time, got %v", first.Metadata["published"])
+	}
+	if first.Metadata["source"] != "The Go Blog" || first.Metadata["age"] != "2 hours ago" {
+		t.Errorf("Unexpected source/age metadata: %v", first.Metadata)
+	}
+	if response.Results[1].Title != "Go point release focuses on security" {
+		t.Errorf("Expected whitespace to be cleaned, got %q", response.Results[1].Title)
+	}
+}
+
+func TestDuckDuckGoProvider_NewsOld(t *testing.T) {
+	server := httptest.NewServer(verticalHandler(t, "/news.js", "news_old.json", nil))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if len(response.Results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(response.Results))
+	}
+
+	expectedPublished := []string{
+		"2019-01-01T00:00:00Z", // epoch seconds as a string
+		"2010-01-01T00:00:00Z", // epoch milliseconds
+		"2012-03-28T00:00:00Z", // RFC3339
+	}
+	for i, expected := range expectedPublished {
+		result := response.Results[i]
+		if result.Metadata["published"] != expected {
+			t.Errorf("Result %d: expected published %s, got %v", i, expected, result.Metadata["published"])
+		}
+		age, _ := result.Metadata["age"].(string)
+		if !strings.HasSuffix(age, "years ago") {
+			t.Errorf("Result %d: expected age derived from date, got %q", i, age)
+		}
+	}
+
+	undated := response.Results[3]
+	if _, ok := undated.Metadata["published"]; ok {
+		t.Errorf("Expected no published metadata for undated article, got %v", undated.Metadata["published"])
+	}
+}
+
+func TestDuckDuckGoProvider_NewsCount(t *testing.T) {
+	server := httptest.NewServer(verticalHandler(t, "/news.js", "news_old.json", nil))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang", "count": float64(2)})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if len(response.Results) != 2 {
+		t.Errorf("Expected results to be limited to 2, got %d", len(response.Results))
+	}
+}
+
+func TestDuckDuckGoProvider_NewsMissingVQD(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body>No token here</body></html>`))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang"})
+	if err == nil || !strings.Contains(err.Error(), "vqd token") {
+		t.Errorf("Expected vqd token error, got %v", err)
+	}
+}
+
+func TestDuckDuckGoProvider_NewsRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang"})
+
+	var rateLimitErr *internetsearch.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got %v", err)
+	}
+}
+
+func TestDuckDuckGoTimestamp_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: `1546300800`, expected: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{input: `"1546300800"`, expected: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{input: `1546300800000`, expected: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{input: `"2019-01-01T10:00:00+10:00"`, expected: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{input: `null`},
+		{input: `""`},
+		{input: `"yesterday"`},
+	}
+
+	for _, tt := range tests {
+		var ts DuckDuckGoTimestamp
+		if err := ts.UnmarshalJSON([]byte(tt.input)); err != nil {
+			t.Errorf("Input %s: unexpected error %v", tt.input, err)
+			continue
+		}
+		if !ts.Equal(tt.expected) {
+			t.Errorf("Input %s: expected %v, got %v", tt.input, tt.expected, ts.Time)
+		}
+	}
+}
+
+func TestRelativeAge(t *testing.T) {
+	now := time.Date(2025, 10, 14, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		published time.Time
+		expected  string
+	}{
+		{published: now.Add(-30 * time.Second), expected: "just now"},
+		{published: now.Add(-1 * time.Minute), expected: "1 minute ago"},
+		{published: now.Add(-5 * time.Hour), expected: "5 hours ago"},
+		{published: now.Add(-3 * 24 * time.Hour), expected: "3 days ago"},
+		{published: now.Add(-65 * 24 * time.Hour), expected: "2 months ago"},
+		{published: now.Add(-800 * 24 * time.Hour), expected: "2 years ago"},
+	}
+
+	for _, tt := range tests {
+		if got := relativeAge(tt.published, now); got != tt.expected {
+			t.Errorf("relativeAge(%v): expected %q, got %q", tt.published, tt.expected, got)
+		}
+	}
+}
+
+func TestDuckDuckGoProvider_UnsupportedType(t *testing.T) {
+	provider := NewDuckDuckGoProvider()
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "image", map[string]any{"query": "golang"}); err == nil {
+		t.Error("Expected error for unsupported search type")
+	}
+}
+
+func TestDuckDuckGoProvider_Video(t *testing.T) {
+	var received http.Request
+	server := httptest.NewServer(verticalHandler(t, "/v.js", "video_search.json", &received))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "video", map[string]any{"query": "golang tutorial"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if got := received.URL.Query().Get("vqd"); got != testVQD {
+		t.Errorf("Expected vqd token %q to be sent, got %q", testVQD, got)
+	}
+
+	if len(response.Results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(response.Results))
+	}
+
+	first := response.Results[0]
+	if first.URL != "https://www.youtube.com/watch?v=YS4e4q9oBaU" {
+		t.Errorf("Expected content URL, got %q", first.URL)
+	}
+	if first.Metadata["embed_url"] != "https://www.youtube.com/embed/YS4e4q9oBaU?autoplay=1" {
+		t.Errorf("Unexpected embed_url: %v", first.Metadata["embed_url"])
+	}
+	if first.Metadata["duration"] != 23974 {
+		t.Errorf("Expected duration of 23974 seconds, got %v", first.Metadata["duration"])
+	}
+	if first.Metadata["views"] != int64(4213567) {
+		t.Errorf("Expected view count, got %v", first.Metadata["views"])
+	}
+	if first.Metadata["uploader"] != "freeCodeCamp.org" || first.Metadata["publisher"] != "YouTube" {
+		t.Errorf("Unexpected uploader/publisher metadata: %v", first.Metadata)
+	}
+	if first.Metadata["published"] != "2019-06-05T14:12:33Z" {
+		t.Errorf("Expected RFC3339 published time, got %v", first.Metadata["published"])
+	}
+	if first.Metadata["thumbnail"] != "https://tse1.mm.bing.net/th?id=OVP.medium" {
+		t.Errorf("Expected medium thumbnail, got %v", first.Metadata["thumbnail"])
+	}
+
+	second := response.Results[1]
+	if second.Metadata["duration"] != 725 {
+		t.Errorf("Expected duration of 725 seconds, got %v", second.Metadata["duration"])
+	}
+	if _, ok := second.Metadata["views"]; ok {
+		t.Errorf("Expected no views metadata for null view count, got %v", second.Metadata["views"])
+	}
+	if second.Description != "A short introduction to goroutines & channels." {
+		t.Errorf("Expected unescaped description, got %q", second.Description)
+	}
+
+	third := response.Results[2]
+	for _, key := range []string{"duration", "views", "embed_url", "published", "thumbnail"} {
+		if _, ok := third.Metadata[key]; ok {
+			t.Errorf("Expected no %s metadata for sparse result, got %v", key, third.Metadata[key])
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+		ok       bool
+	}{
+		{input: "1:02:03", expected: 3723, ok: true},
+		{input: "4:05", expected: 245, ok: true},
+		{input: "59", expected: 59, ok: true},
+		{input: "PT1H2M3S", expected: 3723, ok: true},
+		{input: "PT45S", expected: 45, ok: true},
+		{input: "", ok: false},
+		{input: "live", ok: false},
+		{input: "1:2:3:4", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseDuration(tt.input)
+		if ok != tt.ok || got != tt.expected {
+			t.Errorf("parseDuration(%q): expected (%d, %v), got (%d, %v)", tt.input, tt.expected, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestDuckDuckGoProvider_SupportedTypes(t *testing.T) {
+	provider := NewDuckDuckGoProvider()
+	types := provider.GetSupportedTypes()
+	for _, expected := range []string{"web", "news", "video"} {
+		found := false
+		for _, supported := range types {
+			if supported == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected %q in supported types, got %v", expected, types)
+		}
+	}
+}
+
+// fakeHTTPClient records requests and replies with a fixed body, standing in for the rate limited client
+type fakeHTTPClient struct {
+	requests []*http.Request
+	forms    []url.Values
+	status   int
+	body     string
+}
+
+func (f *fakeHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	f.requests = append(f.requests, req)
+	form := url.Values{}
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		form, _ = url.ParseQuery(string(data))
+	}
+	f.forms = append(f.forms, form)
+
+	status := f.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(f.body)),
+		Request:    req,
+	}, nil
+}
+
+func newFakeWebClient(t *testing.T) *fakeHTTPClient {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "web_search.html"))
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	return &fakeHTTPClient{body: string(data)}
+}
+
+func TestDuckDuckGoProvider_WebResults(t *testing.T) {
+	client := newFakeWebClient(t)
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "effective go"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if len(response.Results) != 2 {
+		t.Fatalf("Expected 2 results with the ad skipped, got %d", len(response.Results))
+	}
+	if response.Results[0].URL != "https://go.dev/doc/effective_go" {
+		t.Errorf("Expected redirect URL to be unwrapped, got %q", response.Results[0].URL)
+	}
+	if response.Region != "" {
+		t.Errorf("Expected no region by default, got %q", response.Region)
+	}
+	if response.Metadata["endpoint"] != endpointHTML || client.requests[0].URL.Host != "html.duckduckgo.com" {
+		t.Errorf("Expected results from the HTML endpoint, got %v from %s", response.Metadata["endpoint"], client.requests[0].URL.Host)
+	}
+	if got := client.forms[0].Get("kl"); got != "" {
+		t.Errorf("Expected empty kl by default, got %q", got)
+	}
+}
+
+func TestNewDuckDuckGoProviderWithClient(t *testing.T) {
+	client := newFakeWebClient(t)
+	if provider := NewDuckDuckGoProviderWithClient(client); provider.client != client {
+		t.Errorf("Expected the given client to be used, got %T", provider.client)
+	}
+	if provider := NewDuckDuckGoProviderWithClient(nil); provider.client == nil {
+		t.Error("Expected a nil client to default to the rate limited client")
+	} else if _, ok := provider.client.(*internetsearch.RateLimitedHTTPClient); !ok {
+		t.Errorf("Expected the rate limited client by default, got %T", provider.client)
+	}
+}
+
+func TestResolveResultURL(t *testing.T) {
+	base, _ := url.Parse("https://html.duckduckgo.com/html")
+
+	tests := []struct {
+		name    string
+		link    string
+		want    string
+		wantErr bool
+	}{
+		{name: "absolute", link: "https://go.dev/doc/", want: "https://go.dev/doc/"},
+		{name: "protocol-relative", link: "//example.com/page", want: "https://example.com/page"},
+		{name: "relative path", link: "/about", want: "https://html.duckduckgo.com/about"},
+		{name: "redirect", link: "//duckduckgo.com/l/?uddg=https%3A%2F%2Fgo.dev%2Fdoc%2F&rut=abc", want: "https://go.dev/doc/"},
+		{name: "relative redirect", link: "/l/?uddg=https%3A%2F%2Fgo.dev%2Fdoc%2F&rut=abc", want: "https://go.dev/doc/"},
+		{name: "encoded ampersands", link: "//duckduckgo.com/l/?uddg=https%3A%2F%2Fexample.com%2Fsearch%3Fa%3D1%26b%3D2&rut=abc", want: "https://example.com/search?a=1&b=2"},
+		{name: "escaped ampersand before rut", link: "//duckduckgo.com/l/?uddg=https%3A%2F%2Fexample.com%2F%3Fa%3D1&amp;rut=abc", want: "https://example.com/?a=1"},
+		{name: "encoded plus signs", link: "//duckduckgo.com/l/?uddg=https%3A%2F%2Fexample.com%2Fsearch%3Fq%3Dc%2B%2B", want: "https://example.com/search?q=c++"},
+		{name: "plus as space", link: "//duckduckgo.com/l/?uddg=https%3A%2F%2Fexample.com%2Fgo+docs", want: "https://example.com/go%20docs"},
+		{name: "double encoded", link: "//duckduckgo.com/l/?uddg=https%253A%252F%252Fexample.com%252Fdocs%253Fa%253D1%2526b%253D2", want: "https://example.com/docs?a=1&b=2"},
+		{name: "double encoded keeps escaped plus signs", link: "//duckduckgo.com/l/?uddg=https%253A%252F%252Fexample.com%252F%253Fq%253Dc%25252B%25252B", want: "https://example.com/?q=c%2B%2B"},
+		{name: "protocol-relative destination", link: "//duckduckgo.com/l/?uddg=%2F%2Fexample.com%2F", want: "https://example.com/"},
+		{name: "empty redirect", link: "//duckduckgo.com/l/?uddg=&rut=abc", wantErr: true},
+		{name: "relative destination", link: "//duckduckgo.com/l/?uddg=%2Fdocs", wantErr: true},
+		{name: "javascript", link: "javascript:void(0)", wantErr: true},
+		{name: "mailto", link: "mailto:gopher@example.com", wantErr: true},
+		{name: "unparseable", link: "http://[::1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveResultURL(base, tt.link)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuckDuckGoProvider_DropsInvalidResultURLs(t *testing.T) {
+	client := &pagedHTTPClient{pages: []string{lastResultsPage("javascript:void(0)", "//example.com/page", "/l/?uddg=https%3A%2F%2Fgo.dev%2F")}}
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	var urls []string
+	for _, result := range response.Results {
+		urls = append(urls, result.URL)
+	}
+	if !slices.Equal(urls, []string{"https://example.com/page", "https://go.dev/"}) {
+		t.Errorf("Expected resolved URLs with the invalid one dropped, got %v", urls)
+	}
+	if response.Results[0].Metadata["position"] != 1 {
+		t.Errorf("Expected positions to skip the dropped result, got %v", response.Results[0].Metadata["position"])
+	}
+}
+
+func TestDuckDuckGoProvider_Region(t *testing.T) {
+	client := newFakeWebClient(t)
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "effective go", "region": "DE-de"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := client.forms[0].Get("kl"); got != "de-de" {
+		t.Errorf("Expected kl=de-de, got %q", got)
+	}
+	if response.Region != "de-de" {
+		t.Errorf("Expected effective region to be recorded, got %q", response.Region)
+	}
+
+	var received http.Request
+	server := httptest.NewServer(verticalHandler(t, "/news.js", "news_fresh.json", &received))
+	defer server.Close()
+
+	response, err = newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang", "region": "au-en"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("l"); got != "au-en" {
+		t.Errorf("Expected l=au-en for news, got %q", got)
+	}
+	if response.Region != "au-en" {
+		t.Errorf("Expected effective region to be recorded, got %q", response.Region)
+	}
+}
+
+func TestDuckDuckGoProvider_InvalidRegion(t *testing.T) {
+	client := newFakeWebClient(t)
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go", "region": "en-us"})
+	if err == nil {
+		t.Fatal("Expected error for invalid region")
+	}
+	if !strings.Contains(err.Error(), "us-en") || !strings.Contains(err.Error(), "de-de") {
+		t.Errorf("Expected error to list valid regions, got %v", err)
+	}
+	if len(client.requests) != 0 {
+		t.Errorf("Expected no request for invalid region, got %d", len(client.requests))
+	}
+}
+
+func TestDuckDuckGoProvider_SafeSearch(t *testing.T) {
+	tests := []struct {
+		safeSearch string
+		expected   string
+	}{
+		{safeSearch: "", expected: "-1"},
+		{safeSearch: "off", expected: "-2"},
+		{safeSearch: "moderate", expected: "-1"},
+		{safeSearch: "strict", expected: "1"},
+	}
+
+	for _, tt := range tests {
+		client := newFakeWebClient(t)
+		provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+		args := map[string]any{"query": "golang"}
+		if tt.safeSearch != "" {
+			args["safesearch"] = tt.safeSearch
+		}
+		if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", args); err != nil {
+			t.Fatalf("safesearch %q: expected success, got error: %v", tt.safeSearch, err)
+		}
+		if got := client.forms[0].Get("kp"); got != tt.expected {
+			t.Errorf("safesearch %q: expected kp=%s, got %q", tt.safeSearch, tt.expected, got)
+		}
+
+		var received http.Request
+		server := httptest.NewServer(verticalHandler(t, "/news.js", "news_fresh.json", &received))
+		if _, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", args); err != nil {
+			t.Fatalf("safesearch %q: expected news success, got error: %v", tt.safeSearch, err)
+		}
+		server.Close()
+		if got := received.URL.Query().Get("p"); got != tt.expected {
+			t.Errorf("safesearch %q: expected news p=%s, got %q", tt.safeSearch, tt.expected, got)
+		}
+	}
+}
+
+func TestDuckDuckGoProvider_InvalidSafeSearch(t *testing.T) {
+	client := newFakeWebClient(t)
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go", "safesearch": "extreme"})
+	if err == nil || !strings.Contains(err.Error(), "off, moderate, strict") {
+		t.Errorf("Expected error listing allowed values, got %v", err)
+	}
+	if len(client.requests) != 0 {
+		t.Errorf("Expected no request for invalid safesearch, got %d", len(client.requests))
+	}
+}
+
+func TestDuckDuckGoProvider_TimeRangeWithRegionAndSafeSearch(t *testing.T) {
+	client := newFakeWebClient(t)
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":      "golang generics & iterators",
+		"region":     "uk-en",
+		"safesearch": "strict",
+		"time_range": "week",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	// Decode the raw body to check the combined form encoding
+	form := client.forms[0]
+	expected := map[string]string{"q": "golang generics & iterators", "kl": "uk-en", "kp": "1", "df": "w"}
+	for key, value := range expected {
+		if got := form.Get(key); got != value {
+			t.Errorf("Expected form %s=%q, got %q", key, value, got)
+		}
+	}
+	if response.TimeRange != "week" || response.Region != "uk-en" {
+		t.Errorf("Expected applied time range and region to be echoed, got %q and %q", response.TimeRange, response.Region)
+	}
+
+	for timeRange, df := range map[string]string{"day": "d", "month": "m", "year": "y"} {
+		client := newFakeWebClient(t)
+		provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+		if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go", "time_range": timeRange}); err != nil {
+			t.Fatalf("time_range %s: expected success, got error: %v", timeRange, err)
+		}
+		if got := client.forms[0].Get("df"); got != df {
+			t.Errorf("time_range %s: expected df=%s, got %q", timeRange, df, got)
+		}
+	}
+}
+
+func TestDuckDuckGoProvider_TimeRangeVerticals(t *testing.T) {
+	var received http.Request
+	server := httptest.NewServer(verticalHandler(t, "/news.js", "news_fresh.json", &received))
+	response, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{
+		"query":      "golang",
+		"region":     "de-de",
+		"time_range": "month",
+	})
+	server.Close()
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	query := received.URL.Query()
+	if query.Get("df") != "m" || query.Get("l") != "de-de" || query.Get("vqd") != testVQD {
+		t.Errorf("Unexpected news query parameters: %v", query)
+	}
+	if response.TimeRange != "month" {
+		t.Errorf("Expected applied time range to be echoed, got %q", response.TimeRange)
+	}
+
+	server = httptest.NewServer(verticalHandler(t, "/v.js", "video_search.json", &received))
+	defer server.Close()
+
+	response, err = newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "video", map[string]any{"query": "golang", "time_range": "week"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("f"); got != "publishedAfter:w" {
+		t.Errorf("Expected video filter publishedAfter:w, got %q", got)
+	}
+
+	// The video vertical cannot filter by year
+	response, err = newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "video", map[string]any{"query": "golang", "time_range": "year"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.URL.Query().Get("f") != "" || response.TimeRange != "" {
+		t.Errorf("Expected year range not to be applied to video search")
+	}
+	if _, ok := response.Metadata["time_range_unsupported"]; !ok {
+		t.Errorf("Expected time_range_unsupported metadata, got %v", response.Metadata)
+	}
+}
+
+// scriptedHTTPClient replies with a sequence of statuses (or network errors), repeating the last one
+type scriptedHTTPClient struct {
+	steps      []scriptedStep
+	body       string
+	forms      []url.Values
+	retryAfter string
+}
+
+type scriptedStep struct {
+	status int
+	err    error
+}
+
+func (c *scriptedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	form := url.Values{}
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		form, _ = url.ParseQuery(string(data))
+	}
+	c.forms = append(c.forms, form)
+
+	step := c.steps[min(len(c.forms), len(c.steps))-1]
+	if step.err != nil {
+		return nil, step.err
+	}
+
+	header := http.Header{}
+	if c.retryAfter != "" {
+		header.Set("Retry-After", c.retryAfter)
+	}
+	return &http.Response{
+		StatusCode: step.status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+func newRetryTestProvider(t *testing.T, steps ...scriptedStep) (*DuckDuckGoProvider, *scriptedHTTPClient) {
+	t.Helper()
+	client := &scriptedHTTPClient{steps: steps, body: newFakeWebClient(t).body}
+	provider := &DuckDuckGoProvider{
+		client:      client,
+		baseURL:     duckDuckGoBaseURL,
+		retryPolicy: internetsearch.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+	}
+	return provider, client
+}
+
+func TestDuckDuckGoProvider_RetriesTransientFailures(t *testing.T) {
+	provider, client := newRetryTestProvider(t,
+		scriptedStep{err: errors.New("connection reset by peer")},
+		scriptedStep{status: http.StatusAccepted},
+		scriptedStep{status: http.StatusOK},
+	)
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "effective go"})
+	if err != nil {
+		t.Fatalf("Expected success after retries, got error: %v", err)
+	}
+
+	if len(client.forms) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", len(client.forms))
+	}
+	// Every attempt must send the full form rather than an already consumed body
+	for i, form := range client.forms {
+		if form.Get("q") != "effective go" {
+			t.Errorf("Attempt %d: expected query in form body, got %v", i+1, form)
+		}
+	}
+	if len(response.Results) == 0 {
+		t.Error("Expected results from the successful attempt")
+	}
+	if response.Metadata["retry_attempts"] != 3 {
+		t.Errorf("Expected retry_attempts=3, got %v", response.Metadata["retry_attempts"])
+	}
+	if _, ok := response.Metadata["retry_wait"].(string); !ok {
+		t.Errorf("Expected retry_wait metadata, got %v", response.Metadata)
+	}
+}
+
+func TestDuckDuckGoProvider_RetryLimits(t *testing.T) {
+	tests := []struct {
+		name         string
+		steps        []scriptedStep
+		retryAfter   string
+		wantAttempts int
+	}{
+		{name: "server errors exhaust attempts", steps: []scriptedStep{{status: http.StatusBadGateway}}, wantAttempts: 3},
+		{name: "client errors are not retried", steps: []scriptedStep{{status: http.StatusForbidden}}, wantAttempts: 1},
+		{name: "long Retry-After is not waited for", steps: []scriptedStep{{status: http.StatusTooManyRequests}}, retryAfter: "120", wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, client := newRetryTestProvider(t, tt.steps...)
+			client.retryAfter = tt.retryAfter
+
+			_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if len(client.forms) != tt.wantAttempts {
+				t.Errorf("Expected %d attempts, got %d", tt.wantAttempts, len(client.forms))
+			}
+		})
+	}
+}
+
+func TestDuckDuckGoProvider_RetryStopsOnCancellation(t *testing.T) {
+	provider, client := newRetryTestProvider(t, scriptedStep{status: http.StatusServiceUnavailable})
+	provider.retryPolicy.BaseDelay = time.Minute
+	provider.retryPolicy.MaxDelay = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := provider.Search(ctx, testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context deadline error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected retry wait to be abandoned on cancellation, took %s", elapsed)
+	}
+	if len(client.forms) != 1 {
+		t.Errorf("Expected a single attempt before cancellation, got %d", len(client.forms))
+	}
+}
+
+func TestDuckDuckGoProvider_BotChallenge(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		wantBlocked bool
+	}{
+		{fixture: "web_challenge.html", wantBlocked: true},
+		{fixture: "web_unexpected.html", wantBlocked: true},
+		{fixture: "web_no_results.html", wantBlocked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatalf("Failed to read fixture: %v", err)
+			}
+			client := &fakeHTTPClient{body: string(data)}
+			provider := &DuckDuckGoProvider{
+				client:      client,
+				baseURL:     duckDuckGoBaseURL,
+				retryPolicy: internetsearch.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			}
+
+			response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+			if !tt.wantBlocked {
+				if err != nil {
+					t.Fatalf("Expected an empty response for a genuine no-results page, got error: %v", err)
+				}
+				if len(response.Results) != 0 {
+					t.Errorf("Expected no results, got %d", len(response.Results))
+				}
+				return
+			}
+
+			if !errors.Is(err, internetsearch.ErrProviderBlocked) {
+				t.Fatalf("Expected ErrProviderBlocked, got %v", err)
+			}
+			if !strings.Contains(err.Error(), "switch to another provider") {
+				t.Errorf("Expected guidance in error, got %q", err.Error())
+			}
+			// The lite endpoint is tried once, but the blocked search isn't retried
+			if len(client.requests) != 2 || client.requests[1].URL.Host != "lite.duckduckgo.com" {
+				t.Errorf("Expected a single lite endpoint fallback and no retry, got %d requests", len(client.requests))
+			}
+		})
+	}
+}
+
+func TestDuckDuckGoProvider_BotChallengeOnVerticals(t *testing.T) {
+	challenge, err := os.ReadFile(filepath.Join("testdata", "web_challenge.html"))
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write(challenge)
+	}))
+	defer server.Close()
+
+	_, err = newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang"})
+	if !errors.Is(err, internetsearch.ErrProviderBlocked) {
+		t.Fatalf("Expected ErrProviderBlocked, got %v", err)
+	}
+}
+
+func TestDuckDuckGoProvider_RemovesDuplicateResults(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "web_duplicates.html"))
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	provider := &DuckDuckGoProvider{client: &fakeHTTPClient{body: string(data)}, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go docs"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if len(response.Results) != 3 {
+		t.Fatalf("Expected 3 results after removing the duplicate, got %d", len(response.Results))
+	}
+	// The higher ranked entry is kept and positions stay contiguous
+	if response.Results[0].Title != "Documentation - The Go Programming Language" {
+		t.Errorf("Expected first occurrence to be kept, got %q", response.Results[0].Title)
+	}
+	if response.Results[1].Metadata["position"] != 2 {
+		t.Errorf("Expected contiguous positions, got %v", response.Results[1].Metadata["position"])
+	}
+	if response.DuplicatesRemoved != 1 {
+		t.Errorf("Expected duplicates_removed=1, got %d", response.DuplicatesRemoved)
+	}
+}
+
+// pagedHTTPClient serves a different HTML page per request, repeating the last one
+type pagedHTTPClient struct {
+	pages []string
+	forms []url.Values
+	hosts []string
+}
+
+func (c *pagedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	data, _ := io.ReadAll(req.Body)
+	form, _ := url.ParseQuery(string(data))
+	c.forms = append(c.forms, form)
+	c.hosts = append(c.hosts, req.URL.Host)
+
+	page := c.pages[min(len(c.forms), len(c.pages))-1]
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(page)),
+		Request:    req,
+	}, nil
+}
+
+// resultsPage renders a minimal DuckDuckGo HTML results page for the URLs, with a Next button
+func resultsPage(urls ...string) string {
+	return renderResultsPage(true, urls)
+}
+
+// lastResultsPage renders a results page without a Next button, as DuckDuckGo serves for the final page
+func lastResultsPage(urls ...string) string {
+	return renderResultsPage(false, urls)
+}
+
+func renderResultsPage(next bool, urls []string) string {
+	var b strings.Builder
+	b.WriteString(`<html><body><div class="results">`)
+	for _, u := range urls {
+		b.WriteString(`<div class="result"><h2 class="result__title"><a class="result__a" href="` + u + `">` + u + `</a></h2><a class="result__snippet">Snippet</a></div>`)
+	}
+	if next {
+		b.WriteString(`<div class="nav-link"><form action="/html/" method="post"><input type="submit" class="btn btn--alt" value="Next" /><input type="hidden" name="s" value="10" /></form></div>`)
+	}
+	b.WriteString(`</div></body></html>`)
+	return b.String()
+}
+
+func TestDuckDuckGoProvider_DomainFilters(t *testing.T) {
+	client := &pagedHTTPClient{pages: []string{
+		resultsPage("https://www.pinterest.com/pin/1", "https://go.dev/doc"),
+		resultsPage("https://go.dev/blog", "https://uk.pinterest.com/pin/2"),
+		resultsPage("https://go.dev/doc", "https://go.dev/blog"),
+	}}
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":           "golang",
+		"count":           float64(3),
+		"include_domains": []any{"go.dev", "pinterest.com"},
+		"exclude_domains": []any{"pinterest.com"},
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	// Include domains become OR-joined site: operators, excludes are filtered afterwards
+	if got := client.forms[0].Get("q"); got != "golang (site:go.dev OR site:pinterest.com)" {
+		t.Errorf("Expected site: operators in query, got %q", got)
+	}
+	if strings.Contains(client.forms[0].Get("q"), "-site:") {
+		t.Error("Expected exclude domains not to be added to the DuckDuckGo query")
+	}
+
+	// Filtering left fewer results than requested, so further pages were fetched up to the cap
+	if len(client.forms) != duckDuckGoMaxWebPages {
+		t.Fatalf("Expected %d pages to be fetched, got %d", duckDuckGoMaxWebPages, len(client.forms))
+	}
+	if client.forms[1].Get("s") != "2" || client.forms[1].Get("dc") != "3" {
+		t.Errorf("Expected second page to continue from offset 2, got s=%q dc=%q", client.forms[1].Get("s"), client.forms[1].Get("dc"))
+	}
+
+	var urls []string
+	for _, result := range response.Results {
+		urls = append(urls, result.URL)
+	}
+	if want := []string{"https://go.dev/doc", "https://go.dev/blog"}; !slices.Equal(urls, want) {
+		t.Errorf("Expected %v, got %v", want, urls)
+	}
+	if response.Results[1].Metadata["position"] != 2 {
+		t.Errorf("Expected positions to follow the filtered order, got %v", response.Results[1].Metadata["position"])
+	}
+	if response.Metadata["domain_filtered"] != 2 {
+		t.Errorf("Expected domain_filtered=2, got %v", response.Metadata["domain_filtered"])
+	}
+}
+
+func TestDuckDuckGoProvider_PagesToMeetCount(t *testing.T) {
+	client := &pagedHTTPClient{pages: []string{
+		resultsPage("https://go.dev/doc", "https://go.dev/blog"),
+		resultsPage("https://pkg.go.dev/", "https://go.dev/tour"),
+		lastResultsPage("https://gobyexample.com/"),
+	}}
+	provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang", "count": float64(3)})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	// The first page is short of the count, so the second is fetched and trimmed to fit
+	if len(client.forms) != 2 || len(response.Results) != 3 {
+		t.Fatalf("Expected 3 results from 2 pages, got %d from %d", len(response.Results), len(client.forms))
+	}
+	if response.Results[2].URL != "https://pkg.go.dev/" || response.Results[2].Metadata["position"] != 3 {
+		t.Errorf("Expected the second page to continue the positions, got %+v", response.Results[2])
+	}
+	if response.Metadata["requested_count"] != 3 || response.Metadata["result_count"] != 3 || response.Metadata["pages_fetched"] != 2 {
+		t.Errorf("Unexpected paging metadata: %v", response.Metadata)
+	}
+}
+
+func TestDuckDuckGoProvider_StopsAtLastPage(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages []string
+		want  int
+	}{
+		{name: "no next button", pages: []string{lastResultsPage("https://go.dev/doc")}, want: 1},
+		{name: "page of repeats", pages: []string{resultsPage("https://go.dev/doc"), resultsPage("https://go.dev/doc")}, want: 2},
+		{name: "empty page", pages: []string{resultsPage("https://go.dev/doc"), resultsPage()}, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &pagedHTTPClient{pages: tt.pages}
+			provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+			response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang", "count": float64(10)})
+			if err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+			if len(client.forms) != tt.want {
+				t.Errorf("Expected %d pages to be requested, got %d", tt.want, len(client.forms))
+			}
+			// Short of the count, the response says so rather than silently under-delivering
+			if response.Metadata["requested_count"] != 10 || response.Metadata["result_count"] != 1 {
+				t.Errorf("Expected requested_count=10 and result_count=1, got %v", response.Metadata)
+			}
+		})
+	}
+}
+
+// readFixtures reads the named testdata fixtures in order
+func readFixtures(t *testing.T, fixtures ...string) []string {
+	t.Helper()
+	pages := make([]string, 0, len(fixtures))
+	for _, fixture := range fixtures {
+		data, err := os.ReadFile(filepath.Join("testdata", fixture))
+		if err != nil {
+			t.Fatalf("Failed to read fixture %s: %v", fixture, err)
+		}
+		pages = append(pages, string(data))
+	}
+	return pages
+}
+
+func TestDuckDuckGoProvider_LiteFallback(t *testing.T) {
+	for _, fixture := range []string{"web_challenge.html", "web_unexpected.html"} {
+		t.Run(fixture, func(t *testing.T) {
+			client := &pagedHTTPClient{pages: readFixtures(t, fixture, "lite_search.html")}
+			provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+			response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "effective go"})
+			if err != nil {
+				t.Fatalf("Expected the lite endpoint to serve results, got error: %v", err)
+			}
+
+			// The repeated lite page holds nothing new, so paging stops after it
+			if !slices.Equal(client.hosts, []string{"html.duckduckgo.com", "lite.duckduckgo.com", "lite.duckduckgo.com"}) {
+				t.Errorf("Expected the HTML endpoint then the lite endpoint for every page, got %v", client.hosts)
+			}
+			if client.forms[2].Get("s") != "3" || client.forms[2].Get("dc") != "4" {
+				t.Errorf("Expected the second lite page to continue from the first, got %v", client.forms[2])
+			}
+			if response.Metadata["endpoint"] != endpointLite {
+				t.Errorf("Expected the lite endpoint in the metadata, got %v", response.Metadata["endpoint"])
+			}
+
+			if len(response.Results) != 3 {
+				t.Fatalf("Expected 3 results with the ad skipped, got %d", len(response.Results))
+			}
+			first := response.Results[0]
+			if first.URL != "https://go.dev/doc/effective_go" || first.Title != "Effective Go - The Go Programming Language" {
+				t.Errorf("Expected an unwrapped URL and cleaned title, got %q (%q)", first.URL, first.Title)
+			}
+			if first.Description != "Tips for writing clear, idiomatic Go code." || first.Metadata["position"] != 1 {
+				t.Errorf("Expected the snippet row as the description, got %q with %v", first.Description, first.Metadata)
+			}
+			if last := response.Results[2]; last.URL != "https://gobyexample.com/" || last.Description != "" {
+				t.Errorf("Expected a result without a snippet to have no description, got %+v", last)
+			}
+		})
+	}
+}
+
+func TestDuckDuckGoProvider_LiteFallbackOutcomes(t *testing.T) {
+	t.Run("no results", func(t *testing.T) {
+		client := &pagedHTTPClient{pages: readFixtures(t, "web_unexpected.html", "lite_no_results.html")}
+		provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+		response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "zxqvjkwplmn"})
+		if err != nil {
+			t.Fatalf("Expected an empty response for a genuine lite no-results page, got error: %v", err)
+		}
+		if len(response.Results) != 0 || response.Metadata["endpoint"] != endpointLite {
+			t.Errorf("Expected no results from the lite endpoint, got %d from %v", len(response.Results), response.Metadata["endpoint"])
+		}
+	})
+
+	t.Run("lite also blocked", func(t *testing.T) {
+		client := &pagedHTTPClient{pages: readFixtures(t, "web_unexpected.html", "web_challenge.html")}
+		provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+		_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+		if !errors.Is(err, internetsearch.ErrProviderBlocked) || !strings.Contains(err.Error(), "bot challenge") {
+			t.Errorf("Expected the lite endpoint's block to be reported, got %v", err)
+		}
+	})
+
+	t.Run("genuine no results skips the lite endpoint", func(t *testing.T) {
+		client := &pagedHTTPClient{pages: readFixtures(t, "web_no_results.html", "lite_search.html")}
+		provider := &DuckDuckGoProvider{client: client, baseURL: duckDuckGoBaseURL}
+
+		response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "zxqvjkwplmn"})
+		if err != nil {
+			t.Fatalf("Expected success, got error: %v", err)
+		}
+		if len(client.hosts) != 1 || response.Metadata["endpoint"] != endpointHTML {
+			t.Errorf("Expected only the HTML endpoint to be used, got %v", client.hosts)
+		}
+	})
+}
+
+func TestDuckDuckGoProvider_DomainFiltersOnNews(t *testing.T) {
+	var received http.Request
+	server := httptest.NewServer(verticalHandler(t, "/news.js", "news_fresh.json", &received))
+	defer server.Close()
+
+	response, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{
+		"query":           "golang",
+		"include_domains": []any{"go.dev"},
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("q"); got != "golang site:go.dev" {
+		t.Errorf("Expected site: operator in news query, got %q", got)
+	}
+	for _, result := range response.Results {
+		if !strings.Contains(result.URL, "go.dev") {
+			t.Errorf("Expected only go.dev results, got %q", result.URL)
+		}
+	}
+}
+
+func TestDuckDuckGoProvider_ErrorClassification(t *testing.T) {
+	errConnReset := errors.New("connection reset by peer")
+	tests := []struct {
+		name  string
+		steps []scriptedStep
+		want  error
+	}{
+		{name: "network errors", steps: []scriptedStep{{err: errConnReset}}, want: internetsearch.ErrNetwork},
+		{name: "rate limits", steps: []scriptedStep{{status: http.StatusTooManyRequests}}, want: internetsearch.ErrRateLimited},
+		{name: "forbidden", steps: []scriptedStep{{status: http.StatusForbidden}}, want: internetsearch.ErrProviderBlocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, _ := newRetryTestProvider(t, tt.steps...)
+			_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// The underlying cause stays reachable for logging
+	provider, _ := newRetryTestProvider(t, scriptedStep{err: errConnReset})
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"}); !errors.Is(err, errConnReset) {
+		t.Errorf("Expected the network error to wrap its cause, got %v", err)
+	}
+}
+
+func TestDuckDuckGoProvider_ParseErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/" && r.URL.Query().Get("q") == "no token":
+			_, _ = w.Write([]byte(`<html><body>Search</body></html>`))
+		case r.URL.Path == "/":
+			_, _ = w.Write([]byte(`<html><script>var x = {vqd="` + testVQD + `"};</script></html>`))
+		default:
+			_, _ = w.Write([]byte(`{"results": [`))
+		}
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	for _, query := range []string{"no token", "golang"} {
+		_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": query})
+		if !errors.Is(err, internetsearch.ErrParse) {
+			t.Errorf("Query %q: expected ErrParse, got %v", query, err)
+		}
+	}
+
+	var syntaxErr *json.SyntaxError
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "golang"})
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("Expected the JSON error to be kept as the cause, got %v", err)
+	}
+}
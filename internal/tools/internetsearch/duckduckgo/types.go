@@ -0,0 +1,96 @@
+package duckduckgo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DuckDuckGoNewsResponse represents the response from the DuckDuckGo news.js endpoint
+type DuckDuckGoNewsResponse struct {
+	Results []DuckDuckGoNewsResult `json:"results"`
+}
+
+// DuckDuckGoNewsResult represents a single news article from the DuckDuckGo news vertical
+type DuckDuckGoNewsResult struct {
+	Title        string              `json:"title"`
+	URL          string              `json:"url"`
+	Excerpt      string              `json:"excerpt"`
+	Source       string              `json:"source"`
+	Image        string              `json:"image,omitempty"`
+	RelativeTime string              `json:"relative_time,omitempty"`
+	Date         DuckDuckGoTimestamp `json:"date"`
+}
+
+// DuckDuckGoVideoResponse represents the response from the DuckDuckGo v.js endpoint
+type DuckDuckGoVideoResponse struct {
+	Results []DuckDuckGoVideoResult `json:"results"`
+}
+
+// DuckDuckGoVideoResult represents a single video from the DuckDuckGo video vertical.
+// Duration, statistics and images are frequently absent, so they are optional.
+type DuckDuckGoVideoResult struct {
+	Title       string                 `json:"title"`
+	Content     string                 `json:"content"`
+	Description string                 `json:"description"`
+	EmbedURL    string                 `json:"embed_url,omitempty"`
+	Duration    string                 `json:"duration,omitempty"`
+	Publisher   string                 `json:"publisher,omitempty"`
+	Uploader    string                 `json:"uploader,omitempty"`
+	Published   DuckDuckGoTimestamp    `json:"published"`
+	Images      *DuckDuckGoVideoImages `json:"images,omitempty"`
+	Statistics  *DuckDuckGoVideoStats  `json:"statistics,omitempty"`
+}
+
+// DuckDuckGoVideoImages contains thumbnail URLs for a video
+type DuckDuckGoVideoImages struct {
+	Small  string `json:"small,omitempty"`
+	Medium string `json:"medium,omitempty"`
+	Large  string `json:"large,omitempty"`
+}
+
+// DuckDuckGoVideoStats contains view statistics for a video
+type DuckDuckGoVideoStats struct {
+	ViewCount *int64 `json:"viewCount"`
+}
+
+// DuckDuckGoTimestamp is a publication time that DuckDuckGo may encode as epoch seconds
+// (number or numeric string), as an RFC3339 string, or as a zone-less timestamp (assumed UTC)
+type DuckDuckGoTimestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving the time zero when the value cannot be parsed
+func (ts *DuckDuckGoTimestamp) UnmarshalJSON(data []byte) error {
+	raw := strings.TrimSpace(string(data))
+	if raw == "" || raw == "null" {
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(raw); err == nil {
+		raw = strings.TrimSpace(unquoted)
+	}
+	if raw == "" {
+		return nil
+	}
+
+	if epoch, err := strconv.ParseFloat(raw, 64); err == nil {
+		// Values in the trillions are epoch milliseconds rather than seconds
+		if epoch > 1e12 {
+			epoch /= 1000
+		}
+		ts.Time = time.Unix(int64(epoch), 0).UTC()
+		return nil
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+		ts.Time = parsed.UTC()
+		return nil
+	}
+
+	// The video vertical uses timestamps such as 2024-05-01T10:00:00.0000000 without a zone
+	if parsed, err := time.Parse("2006-01-02T15:04:05", raw); err == nil {
+		ts.Time = parsed.UTC()
+	}
+	return nil
+}
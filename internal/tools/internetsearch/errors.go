@@ -0,0 +1,109 @@
+package internetsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Provider failures are classified by wrapping one of these sentinels, e.g. fmt.Errorf("%w: invalid API key", ErrAuth),
+// so callers can pick a message and decide whether to fall back with errors.Is while the cause is kept for logging
+var (
+	// ErrAuth is returned when a provider rejects the API key or it lacks access to the endpoint
+	ErrAuth = errors.New("authentication failed")
+	// ErrRateLimited matches a RateLimitError, use errors.As to read its RetryAfter
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrQuotaExceeded is returned when the account's usage quota or plan limit has been used up
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrProviderBlocked is returned when a provider refuses to serve results, e.g. with a bot challenge page
+	ErrProviderBlocked = errors.New("provider blocked the request")
+	// ErrParse is returned when a provider's response can't be understood, usually after an API or page change
+	ErrParse = errors.New("unexpected response format")
+	// ErrNetwork is returned when a provider can't be reached or the response can't be read
+	ErrNetwork = errors.New("network error")
+)
+
+// RateLimitError is returned when a provider rejects a request due to rate limiting
+type RateLimitError struct {
+	Provider   string
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limit exceeded: %s, retry after %s", e.Provider, e.RetryAfter)
+	}
+	return fmt.Sprintf("rate limit exceeded: %s, please wait before retrying", e.Provider)
+}
+
+// Is reports whether the target is ErrRateLimited, so rate limits can be checked with errors.Is
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// TimeoutError is returned when a provider doesn't respond within the search timeout. It matches
+// context.DeadlineExceeded so it isn't retried, but is distinct from ErrNetwork for connection failures.
+type TimeoutError struct {
+	Provider string
+	Timeout  time.Duration
+}
+
+// Error implements the error interface
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("search timed out after %s via provider %s", e.Timeout, e.Provider)
+}
+
+// Unwrap returns context.DeadlineExceeded
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// classifiedError keeps a provider's message as is while matching a sentinel with errors.Is
+type classifiedError struct {
+	message string
+	kind    error
+}
+
+// Error implements the error interface
+func (e *classifiedError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the sentinel the error is classified as
+func (e *classifiedError) Unwrap() error {
+	return e.kind
+}
+
+// Classify returns an error with the given message that matches kind, for messages that already
+// explain the failure and shouldn't gain the sentinel's text as a prefix
+func Classify(kind error, message string) error {
+	return &classifiedError{message: message, kind: kind}
+}
+
+// ParseRetryAfter parses a Retry-After header value, which may be either a number of seconds or an HTTP date
+func ParseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if retryAt, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(retryAt); wait > 0 {
+			return wait.Round(time.Second)
+		}
+	}
+
+	return 0
+}
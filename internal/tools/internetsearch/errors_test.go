@@ -0,0 +1,55 @@
+package internetsearch
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestRateLimitError_Is(t *testing.T) {
+	err := fmt.Errorf("news search failed: %w", &RateLimitError{Provider: "duckduckgo", RetryAfter: 5 * time.Second})
+
+	if !errors.Is(err, ErrRateLimited) {
+		t.Error("Expected a wrapped RateLimitError to match ErrRateLimited")
+	}
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 5*time.Second {
+		t.Errorf("Expected errors.As to recover the RetryAfter, got %v", rateLimitErr)
+	}
+	if errors.Is(err, ErrQuotaExceeded) || errors.Is(errors.New("rate limit exceeded"), ErrRateLimited) {
+		t.Error("Expected ErrRateLimited to only match RateLimitError")
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	sentinels := []error{ErrAuth, ErrRateLimited, ErrQuotaExceeded, ErrProviderBlocked, ErrParse, ErrNetwork}
+
+	for _, sentinel := range sentinels {
+		err := Retryable(fmt.Errorf("%w: failed to read response: %w", sentinel, io.ErrUnexpectedEOF))
+
+		for _, other := range sentinels {
+			if got := errors.Is(err, other); got != (other == sentinel) {
+				t.Errorf("errors.Is(%q, %v) = %v", err, other, got)
+			}
+		}
+		// Classifying an error keeps the cause for logging and the retryable marker
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("Expected %q to wrap its cause", err)
+		}
+		if !IsRetryable(err) {
+			t.Errorf("Expected %q to stay retryable", err)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	err := Classify(ErrAuth, "access forbidden: check your API key and subscription plan")
+	if err.Error() != "access forbidden: check your API key and subscription plan" {
+		t.Errorf("Expected the message to be kept unchanged, got %q", err)
+	}
+	if !errors.Is(err, ErrAuth) || errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Expected %q to only match ErrAuth", err)
+	}
+}
@@ -0,0 +1,104 @@
+package internetsearch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Safe search levels accepted by the safesearch argument
+const (
+	SafeSearchOff      = "off"
+	SafeSearchModerate = "moderate"
+	SafeSearchStrict   = "strict"
+)
+
+// legacySafeSearchLevels maps SearXNG's numeric levels, which the safesearch argument originally used
+var legacySafeSearchLevels = map[string]string{
+	"0": SafeSearchOff,
+	"1": SafeSearchModerate,
+	"2": SafeSearchStrict,
+}
+
+// ParseSafeSearch reads and validates the optional safesearch argument, defaulting to moderate
+func ParseSafeSearch(args map[string]any) (string, error) {
+	raw, ok := args["safesearch"].(string)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return SafeSearchModerate, nil
+	}
+
+	level := strings.ToLower(strings.TrimSpace(raw))
+	if legacy, exists := legacySafeSearchLevels[level]; exists {
+		return legacy, nil
+	}
+
+	switch level {
+	case SafeSearchOff, SafeSearchModerate, SafeSearchStrict:
+		return level, nil
+	default:
+		return "", fmt.Errorf("invalid safesearch %q, must be one of: %s, %s, %s", raw, SafeSearchOff, SafeSearchModerate, SafeSearchStrict)
+	}
+}
+
+// Time ranges accepted by the time_range argument
+const (
+	TimeRangeDay   = "day"
+	TimeRangeWeek  = "week"
+	TimeRangeMonth = "month"
+	TimeRangeYear  = "year"
+)
+
+// ParseTimeRange reads and validates the optional time_range argument, returning "" when unset
+func ParseTimeRange(args map[string]any) (string, error) {
+	raw, ok := args["time_range"].(string)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+
+	timeRange := strings.ToLower(strings.TrimSpace(raw))
+	switch timeRange {
+	case TimeRangeDay, TimeRangeWeek, TimeRangeMonth, TimeRangeYear:
+		return timeRange, nil
+	default:
+		return "", fmt.Errorf("invalid time_range %q, must be one of: %s, %s, %s, %s", raw, TimeRangeDay, TimeRangeWeek, TimeRangeMonth, TimeRangeYear)
+	}
+}
+
+// TimeRangeStart returns the earliest time covered by a time range ending now, for providers that
+// filter by date rather than accepting a named range. The zero time is returned for no time range.
+func TimeRangeStart(timeRange string, now time.Time) time.Time {
+	switch timeRange {
+	case TimeRangeDay:
+		return now.AddDate(0, 0, -1)
+	case TimeRangeWeek:
+		return now.AddDate(0, 0, -7)
+	case TimeRangeMonth:
+		return now.AddDate(0, -1, 0)
+	case TimeRangeYear:
+		return now.AddDate(-1, 0, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// Result orders accepted by the sort argument, for providers that can rank by date as well as relevance
+const (
+	SortRelevance = "relevance"
+	SortDate      = "date" // Newest first
+)
+
+// ParseSort reads and validates the optional sort argument, returning defaultSort when unset
+func ParseSort(args map[string]any, defaultSort string) (string, error) {
+	raw, ok := args["sort"].(string)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return defaultSort, nil
+	}
+
+	sort := strings.ToLower(strings.TrimSpace(raw))
+	switch sort {
+	case SortRelevance, SortDate:
+		return sort, nil
+	default:
+		return "", fmt.Errorf("invalid sort %q, must be one of: %s, %s", raw, SortRelevance, SortDate)
+	}
+}
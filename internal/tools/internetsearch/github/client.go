@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// GitHubAPIBaseURL is the base URL for the GitHub REST API
+	GitHubAPIBaseURL = "https://api.github.com"
+
+	// UserAgent for API requests, GitHub rejects requests without one
+	UserAgent = "mcp-devtools/1.0"
+
+	// gitHubAPIVersion pins the REST API version the response types were written against
+	gitHubAPIVersion = "2022-11-28"
+
+	// textMatchMediaType asks for the matched fragments to be included with code search results
+	textMatchMediaType = "application/vnd.github.text-match+json"
+)
+
+// GitHubClient handles HTTP requests to the GitHub search API
+type GitHubClient struct {
+	token      string
+	httpClient internetsearch.HTTPClientInterface
+	baseURL    string
+	now        func() time.Time
+}
+
+// NewGitHubClient creates a new GitHub search API client with rate limiting. The token is optional,
+// but unauthenticated requests have a much lower rate limit and can't use code search.
+func NewGitHubClient(token string) *GitHubClient {
+	return &GitHubClient{
+		token:      token,
+		baseURL:    GitHubAPIBaseURL,
+		httpClient: internetsearch.NewRateLimitedHTTPClient(),
+		now:        time.Now,
+	}
+}
+
+// SearchRepositories searches repositories using GitHub search syntax
+func (c *GitHubClient) SearchRepositories(ctx context.Context, logger *logrus.Logger, query string, perPage int) (*GitHubRepositorySearchResponse, error) {
+	var response GitHubRepositorySearchResponse
+	if err := c.search(ctx, logger, "/search/repositories", query, perPage, "application/vnd.github+json", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// SearchCode searches file contents using GitHub search syntax, including the matched text fragments
+func (c *GitHubClient) SearchCode(ctx context.Context, logger *logrus.Logger, query string, perPage int) (*GitHubCodeSearchResponse, error) {
+	var response GitHubCodeSearchResponse
+	if err := c.search(ctx, logger, "/search/code", query, perPage, textMatchMediaType, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// search performs a request against a search endpoint and decodes the response into target
+func (c *GitHubClient) search(ctx context.Context, logger *logrus.Logger, endpoint, query string, perPage int, accept string, target any) error {
+	reqURL, err := url.Parse(c.baseURL + endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Check domain access security for API endpoint using security helper
+	if err := security.CheckDomainAccess(reqURL.Hostname()); err != nil {
+		if secErr, ok := err.(*security.SecurityError); ok {
+			return security.FormatSecurityBlockError(secErr)
+		}
+		return err
+	}
+
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("per_page", strconv.Itoa(perPage))
+	reqURL.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-GitHub-Api-Version", gitHubAPIVersion)
+	req.Header.Set("User-Agent", UserAgent)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"url":           reqURL.String(),
+		"authenticated": c.token != "",
+	}).Debug("Making GitHub search API request")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("Failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return internetsearch.Retryable(fmt.Errorf("%w: failed to read response body: %w", internetsearch.ErrNetwork, err))
+	}
+	internetsearch.CaptureResponse(ctx, logger, "github", resp, body)
+
+	// Security analysis on content
+	if security.IsEnabled() {
+		sourceCtx := security.SourceContext{
+			URL:         reqURL.String(),
+			Domain:      reqURL.Hostname(),
+			ContentType: resp.Header.Get("Content-Type"),
+			Tool:        "internetsearch",
+		}
+
+		if secResult, err := security.AnalyseContent(string(body), sourceCtx); err == nil {
+			switch secResult.Action {
+			case security.ActionBlock:
+				return security.FormatSecurityBlockErrorFromResult(secResult)
+			case security.ActionWarn:
+				logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
+			}
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return c.translateError(logger, resp, body)
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("%w: failed to parse search response: %w", internetsearch.ErrParse, err)
+	}
+	return nil
+}
+
+// translateError converts a GitHub error response into a classified error. GitHub reports both its
+// primary (hourly) and secondary (abuse) rate limits as 403 or 429, distinguished from permission
+// errors by the Retry-After and X-RateLimit headers or the error message.
+func (c *GitHubClient) translateError(logger *logrus.Logger, resp *http.Response, body []byte) error {
+	logger.WithFields(logrus.Fields{
+		"status_code": resp.StatusCode,
+		"status":      resp.Status,
+	}).Error("GitHub search API request failed")
+
+	message := ""
+	var errorResp GitHubErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		message = errorResp.Message
+		for _, detail := range errorResp.Errors {
+			if detail.Message != "" {
+				message += ": " + detail.Message
+			}
+		}
+	}
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if rateLimitErr := c.rateLimitError(resp.Header, message); rateLimitErr != nil {
+			return rateLimitErr
+		}
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return fmt.Errorf("%w: GitHub rejected the token, check GITHUB_TOKEN: %s", internetsearch.ErrAuth, message)
+	case resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("%w: GitHub denied the search: %s", internetsearch.ErrAuth, message)
+	case resp.StatusCode == http.StatusUnprocessableEntity:
+		return fmt.Errorf("invalid GitHub search query: %s", message)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return internetsearch.Retryable(fmt.Errorf("GitHub API error: status %d", resp.StatusCode))
+	}
+
+	if message != "" {
+		return fmt.Errorf("GitHub API error (%d): %s", resp.StatusCode, message)
+	}
+	return fmt.Errorf("GitHub API request failed with status %d: %s", resp.StatusCode, string(body))
+}
+
+// rateLimitError returns a RateLimitError when the response shows a rate limit, or nil otherwise.
+// Secondary limits usually send Retry-After, while an exhausted primary limit sends the reset time instead.
+// A secondary limit without either is left to the retry policy's exponential backoff.
+func (c *GitHubClient) rateLimitError(header http.Header, message string) error {
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		return &internetsearch.RateLimitError{Provider: "github", RetryAfter: internetsearch.ParseRetryAfter(retryAfter)}
+	}
+	if strings.Contains(strings.ToLower(message), "secondary rate limit") {
+		return &internetsearch.RateLimitError{Provider: "github"}
+	}
+	if header.Get("X-RateLimit-Remaining") != "0" {
+		return nil
+	}
+
+	rateLimitErr := &internetsearch.RateLimitError{Provider: "github"}
+	if reset, err := strconv.ParseInt(strings.TrimSpace(header.Get("X-RateLimit-Reset")), 10, 64); err == nil {
+		rateLimitErr.RetryAfter = max(time.Unix(reset, 0).Sub(c.now()).Round(time.Second), 0)
+	}
+	return rateLimitErr
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// GitHub search API result limits
+	gitHubMinResults = 1
+	gitHubMaxResults = 100
+
+	// Search types served by this provider
+	SearchTypeRepositories = "repositories"
+	SearchTypeCode         = "code"
+)
+
+// GitHubProvider implements the unified SearchProvider interface
+type GitHubProvider struct {
+	client      *GitHubClient
+	retryPolicy internetsearch.RetryPolicy
+}
+
+// NewGitHubProvider creates a new GitHub search provider, authenticating with GITHUB_TOKEN when it is set
+func NewGitHubProvider() *GitHubProvider {
+	return &GitHubProvider{
+		client:      NewGitHubClient(strings.TrimSpace(os.Getenv("GITHUB_TOKEN"))),
+		retryPolicy: internetsearch.DefaultRetryPolicy(),
+	}
+}
+
+// GetName returns the provider name
+func (p *GitHubProvider) GetName() string {
+	return "github"
+}
+
+// IsAvailable checks if the provider is available (always true as the token is optional)
+func (p *GitHubProvider) IsAvailable() bool {
+	return p.client != nil
+}
+
+// GetSupportedTypes returns the search types this provider supports
+func (p *GitHubProvider) GetSupportedTypes() []string {
+	return []string{SearchTypeRepositories, SearchTypeCode}
+}
+
+// Describe returns how the provider is configured, for provider listings
+func (p *GitHubProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "GitHub repository and code search, code search requires a token",
+		OptionalEnvVars: []string{"GITHUB_TOKEN"},
+	}
+}
+
+// Search executes a search using the GitHub provider. The query accepts GitHub search qualifiers
+// such as "language:go" or "org:golang".
+func (p *GitHubProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	logger.WithFields(logrus.Fields{
+		"provider": "github",
+		"type":     searchType,
+		"query":    query,
+	}).Debug("GitHub search parameters")
+
+	perPage := 10
+	if countRaw, ok := args["count"].(float64); ok {
+		perPage = int(countRaw)
+		if perPage < gitHubMinResults || perPage > gitHubMaxResults {
+			return nil, fmt.Errorf("count must be between %d and %d for GitHub search, got %d", gitHubMinResults, gitHubMaxResults, perPage)
+		}
+	}
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *internetsearch.SearchResponse
+	stats, err := internetsearch.Retry(ctx, logger, p.retryPolicy, func() error {
+		var searchErr error
+		switch searchType {
+		case SearchTypeRepositories:
+			response, searchErr = p.executeRepositorySearch(ctx, logger, query, perPage, timeRange)
+		case SearchTypeCode:
+			response, searchErr = p.executeCodeSearch(ctx, logger, query, perPage)
+		default:
+			return fmt.Errorf("unsupported search type for GitHub: %s", searchType)
+		}
+		return searchErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("github search failed: %w", err)
+	}
+
+	stats.Apply(response)
+	// Code search has no date qualifier, so only repository searches can honour a time range
+	response.ApplyTimeRange(timeRange, searchType == SearchTypeRepositories)
+	return response, nil
+}
+
+// executeRepositorySearch handles repository search, mapping the time range to a pushed: qualifier
+func (p *GitHubProvider) executeRepositorySearch(ctx context.Context, logger *logrus.Logger, query string, perPage int, timeRange string) (*internetsearch.SearchResponse, error) {
+	searchQuery := query
+	if start := internetsearch.TimeRangeStart(timeRange, p.client.now()); !start.IsZero() {
+		searchQuery += " pushed:>=" + start.Format(time.DateOnly)
+	}
+
+	response, err := p.client.SearchRepositories(ctx, logger, searchQuery, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]internetsearch.SearchResult, 0, len(response.Items))
+	for i, repo := range response.Items {
+		metadata := map[string]any{
+			"position":    i + 1,
+			"stars":       repo.StargazersCount,
+			"forks":       repo.ForksCount,
+			"open_issues": repo.OpenIssuesCount,
+		}
+		if repo.Language != "" {
+			metadata["language"] = repo.Language
+		}
+		if len(repo.Topics) > 0 {
+			metadata["topics"] = repo.Topics
+		}
+		if repo.License != nil && repo.License.SPDXID != "" && repo.License.SPDXID != "NOASSERTION" {
+			metadata["license"] = repo.License.SPDXID
+		}
+		if repo.PushedAt != "" {
+			metadata["pushed_at"] = repo.PushedAt
+		}
+		if repo.Archived {
+			metadata["archived"] = true
+		}
+
+		results = append(results, internetsearch.SearchResult{
+			Title:       repo.FullName,
+			URL:         repo.HTMLURL,
+			Description: repo.Description,
+			Metadata:    metadata,
+		})
+	}
+
+	return p.createSuccessResponse(query, results, response.TotalCount, response.IncompleteResults, logger), nil
+}
+
+// executeCodeSearch handles code search, which GitHub only allows for authenticated requests
+func (p *GitHubProvider) executeCodeSearch(ctx context.Context, logger *logrus.Logger, query string, perPage int) (*internetsearch.SearchResponse, error) {
+	if p.client.token == "" {
+		return nil, fmt.Errorf("%w: GitHub code search requires authentication, set GITHUB_TOKEN", internetsearch.ErrAuth)
+	}
+
+	response, err := p.client.SearchCode(ctx, logger, query, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]internetsearch.SearchResult, 0, len(response.Items))
+	for i, item := range response.Items {
+		metadata := map[string]any{
+			"position":       i + 1,
+			"repository":     item.Repository.FullName,
+			"repository_url": item.Repository.HTMLURL,
+			"path":           item.Path,
+		}
+		if item.SHA != "" {
+			metadata["sha"] = item.SHA
+		}
+
+		var fragments []string
+		for _, match := range item.TextMatches {
+			if match.Property == "content" && strings.TrimSpace(match.Fragment) != "" {
+				fragments = append(fragments, strings.TrimSpace(match.Fragment))
+			}
+		}
+		description := ""
+		if len(fragments) > 0 {
+			description = fragments[0]
+			metadata["fragments"] = fragments
+		}
+
+		results = append(results, internetsearch.SearchResult{
+			Title:       item.Repository.FullName + "/" + item.Path,
+			URL:         item.HTMLURL,
+			Description: description,
+			Metadata:    metadata,
+		})
+	}
+
+	return p.createSuccessResponse(query, results, response.TotalCount, response.IncompleteResults, logger), nil
+}
+
+// Helper functions
+func (p *GitHubProvider) createSuccessResponse(query string, results []internetsearch.SearchResult, totalCount int, incomplete bool, logger *logrus.Logger) *internetsearch.SearchResponse {
+	result := &internetsearch.SearchResponse{
+		Results:   results,
+		Provider:  "github",
+		Timestamp: time.Now(),
+	}
+	result.SetMetadata("total_count", totalCount)
+	if incomplete {
+		// GitHub stops searching when a query takes too long, so some matches may be missing
+		result.SetMetadata("incomplete_results", true)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"query":        query,
+		"result_count": len(results),
+		"provider":     "github",
+	}).Info("GitHub search completed successfully")
+
+	return result
+}
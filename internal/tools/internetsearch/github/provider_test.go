@@ -0,0 +1,306 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const repositorySearchFixture = `{
+  "total_count": 1234,
+  "incomplete_results": false,
+  "items": [
+    {
+      "full_name": "golang/go",
+      "html_url": "https://github.com/golang/go",
+      "description": "The Go programming language",
+      "language": "Go",
+      "stargazers_count": 125000,
+      "forks_count": 17600,
+      "open_issues_count": 9000,
+      "topics": ["go", "language"],
+      "archived": false,
+      "pushed_at": "2024-03-01T10:00:00Z",
+      "license": {"spdx_id": "BSD-3-Clause"}
+    },
+    {
+      "full_name": "example/old-tool",
+      "html_url": "https://github.com/example/old-tool",
+      "description": null,
+      "language": null,
+      "stargazers_count": 12,
+      "forks_count": 1,
+      "open_issues_count": 0,
+      "topics": [],
+      "archived": true,
+      "pushed_at": "2019-01-01T00:00:00Z",
+      "license": {"spdx_id": "NOASSERTION"}
+    }
+  ]
+}`
+
+const codeSearchFixture = `{
+  "total_count": 2,
+  "incomplete_results": true,
+  "items": [
+    {
+      "name": "retry.go",
+      "path": "internal/retry/retry.go",
+      "sha": "abc123",
+      "html_url": "https://github.com/example/service/blob/abc123/internal/retry/retry.go",
+      "repository": {"full_name": "example/service", "html_url": "https://github.com/example/service"},
+      "text_matches": [
+        {"property": "content", "fragment": "func Backoff(attempt int) time.Duration {\n\treturn base << attempt\n}"},
+        {"property": "path", "fragment": "internal/retry/retry.go"},
+        {"property": "content", "fragment": "// Backoff doubles the delay"}
+      ]
+    }
+  ]
+}`
+
+func newTestProvider(server *httptest.Server, token string) *GitHubProvider {
+	return &GitHubProvider{
+		client: &GitHubClient{
+			token:      token,
+			baseURL:    server.URL,
+			httpClient: server.Client(),
+			now:        func() time.Time { return time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC) },
+		},
+		retryPolicy: internetsearch.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second},
+	}
+}
+
+func TestGitHubProvider_RepositorySearch(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		_, _ = w.Write([]byte(repositorySearchFixture))
+	}))
+	defer server.Close()
+
+	response, err := newTestProvider(server, "").Search(context.Background(), testutils.DiscardLogger(), "repositories", map[string]any{
+		"query":      "language:go http router",
+		"count":      float64(2),
+		"time_range": "month",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.URL.Path != "/search/repositories" || received.Header.Get("Authorization") != "" {
+		t.Errorf("Expected an unauthenticated repository search, got %s with Authorization %q", received.URL.Path, received.Header.Get("Authorization"))
+	}
+	query := received.URL.Query()
+	if query.Get("q") != "language:go http router pushed:>=2024-02-15" || query.Get("per_page") != "2" {
+		t.Errorf("Unexpected query parameters: %v", query)
+	}
+	if response.TimeRange != "month" || response.Metadata["total_count"] != 1234 {
+		t.Errorf("Expected time range and total count on the response, got %q and %v", response.TimeRange, response.Metadata)
+	}
+
+	if len(response.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(response.Results))
+	}
+	repo := response.Results[0]
+	if repo.Title != "golang/go" || repo.URL != "https://github.com/golang/go" || repo.Description != "The Go programming language" {
+		t.Errorf("Unexpected repository result: %+v", repo)
+	}
+	if repo.Metadata["stars"] != 125000 || repo.Metadata["language"] != "Go" || repo.Metadata["license"] != "BSD-3-Clause" {
+		t.Errorf("Unexpected repository metadata: %v", repo.Metadata)
+	}
+	if topics, _ := repo.Metadata["topics"].([]string); !slices.Equal(topics, []string{"go", "language"}) {
+		t.Errorf("Expected topics metadata, got %v", repo.Metadata["topics"])
+	}
+
+	old := response.Results[1].Metadata
+	if old["archived"] != true {
+		t.Errorf("Expected archived metadata, got %v", old)
+	}
+	if _, ok := old["license"]; ok {
+		t.Errorf("Expected no licence for NOASSERTION, got %v", old["license"])
+	}
+	if _, ok := old["language"]; ok {
+		t.Errorf("Expected no language when GitHub returns null, got %v", old["language"])
+	}
+}
+
+func TestGitHubProvider_CodeSearch(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		_, _ = w.Write([]byte(codeSearchFixture))
+	}))
+	defer server.Close()
+
+	response, err := newTestProvider(server, "ghp_test").Search(context.Background(), testutils.DiscardLogger(), "code", map[string]any{
+		"query":      "Backoff repo:example/service",
+		"time_range": "week",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.URL.Path != "/search/code" || received.Header.Get("Authorization") != "Bearer ghp_test" {
+		t.Errorf("Expected an authenticated code search, got %s with Authorization %q", received.URL.Path, received.Header.Get("Authorization"))
+	}
+	if received.Header.Get("Accept") != textMatchMediaType {
+		t.Errorf("Expected the text-match media type, got %q", received.Header.Get("Accept"))
+	}
+	if strings.Contains(received.URL.Query().Get("q"), "pushed:") {
+		t.Errorf("Expected no date qualifier for code search, got %q", received.URL.Query().Get("q"))
+	}
+	if _, ok := response.Metadata["time_range_unsupported"]; !ok {
+		t.Errorf("Expected time_range_unsupported for code search, got %v", response.Metadata)
+	}
+	if response.Metadata["incomplete_results"] != true {
+		t.Errorf("Expected incomplete_results metadata, got %v", response.Metadata)
+	}
+
+	result := response.Results[0]
+	if result.Title != "example/service/internal/retry/retry.go" || !strings.HasPrefix(result.Description, "func Backoff") {
+		t.Errorf("Unexpected code result: %q, %q", result.Title, result.Description)
+	}
+	if result.Metadata["repository"] != "example/service" || result.Metadata["path"] != "internal/retry/retry.go" {
+		t.Errorf("Unexpected code metadata: %v", result.Metadata)
+	}
+	if fragments, _ := result.Metadata["fragments"].([]string); len(fragments) != 2 {
+		t.Errorf("Expected the two content fragments, got %v", result.Metadata["fragments"])
+	}
+}
+
+func TestGitHubProvider_CodeSearchRequiresToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request without a token")
+	}))
+	defer server.Close()
+
+	_, err := newTestProvider(server, "").Search(context.Background(), testutils.DiscardLogger(), "code", map[string]any{"query": "Backoff"})
+	if !errors.Is(err, internetsearch.ErrAuth) || !strings.Contains(err.Error(), "GITHUB_TOKEN") {
+		t.Errorf("Expected an ErrAuth naming GITHUB_TOKEN, got %v", err)
+	}
+}
+
+func TestGitHubProvider_SecondaryRateLimitRetried(t *testing.T) {
+	// Without Retry-After the retry policy's own backoff is used
+	for _, retryAfter := range []string{"1", ""} {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				if retryAfter != "" {
+					w.Header().Set("Retry-After", retryAfter)
+				}
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit."}`))
+				return
+			}
+			_, _ = w.Write([]byte(repositorySearchFixture))
+		}))
+
+		response, err := newTestProvider(server, "").Search(context.Background(), testutils.DiscardLogger(), "repositories", map[string]any{"query": "router"})
+		server.Close()
+		if err != nil {
+			t.Fatalf("Retry-After %q: expected the retry to succeed, got error: %v", retryAfter, err)
+		}
+		if requests.Load() != 2 || response.Metadata["retry_attempts"] != 2 {
+			t.Errorf("Retry-After %q: expected 2 attempts, got %d requests and metadata %v", retryAfter, requests.Load(), response.Metadata)
+		}
+	}
+}
+
+func TestGitHubProvider_Errors(t *testing.T) {
+	reset := time.Date(2024, 3, 15, 12, 5, 0, 0, time.UTC).Unix()
+
+	tests := []struct {
+		name           string
+		status         int
+		headers        map[string]string
+		body           string
+		wantRetryAfter time.Duration // Checked when the error should be a rate limit
+		check          func(error) bool
+	}{
+		{
+			name:           "secondary limit beyond the retry limit",
+			status:         http.StatusForbidden,
+			headers:        map[string]string{"Retry-After": "60"},
+			body:           `{"message": "You have exceeded a secondary rate limit."}`,
+			wantRetryAfter: time.Minute,
+		},
+		{
+			name:           "secondary limit without retry-after",
+			status:         http.StatusForbidden,
+			body:           `{"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`,
+			wantRetryAfter: 0,
+		},
+		{
+			name:           "primary limit exhausted",
+			status:         http.StatusForbidden,
+			headers:        map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(reset, 10)},
+			body:           `{"message": "API rate limit exceeded for 203.0.113.1."}`,
+			wantRetryAfter: 5 * time.Minute,
+		},
+		{
+			name:   "forbidden without rate limit headers",
+			status: http.StatusForbidden,
+			body:   `{"message": "Resource not accessible by personal access token"}`,
+			check: func(err error) bool {
+				return errors.Is(err, internetsearch.ErrAuth) && !errors.Is(err, internetsearch.ErrRateLimited)
+			},
+		},
+		{
+			name:   "bad token",
+			status: http.StatusUnauthorized,
+			body:   `{"message": "Bad credentials"}`,
+			check:  func(err error) bool { return errors.Is(err, internetsearch.ErrAuth) },
+		},
+		{
+			name:   "invalid query",
+			status: http.StatusUnprocessableEntity,
+			body:   `{"message": "Validation Failed", "errors": [{"message": "The search contains only logical operators"}]}`,
+			check: func(err error) bool {
+				return strings.Contains(err.Error(), "Validation Failed: The search contains only logical operators")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for key, value := range tt.headers {
+					w.Header().Set(key, value)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := newTestProvider(server, "ghp_test").Search(context.Background(), testutils.DiscardLogger(), "repositories", map[string]any{"query": "router"})
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if tt.check != nil {
+				if !tt.check(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+
+			var rateLimitErr *internetsearch.RateLimitError
+			if !errors.As(err, &rateLimitErr) || !errors.Is(err, internetsearch.ErrRateLimited) {
+				t.Fatalf("Expected a rate limit error, got %v", err)
+			}
+			if rateLimitErr.RetryAfter != tt.wantRetryAfter {
+				t.Errorf("Expected retry after %s, got %s", tt.wantRetryAfter, rateLimitErr.RetryAfter)
+			}
+		})
+	}
+}
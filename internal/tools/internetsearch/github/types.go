@@ -0,0 +1,65 @@
+package github
+
+// GitHubRepositorySearchResponse represents the response from the GitHub repository search API
+type GitHubRepositorySearchResponse struct {
+	TotalCount        int                `json:"total_count"`
+	IncompleteResults bool               `json:"incomplete_results"`
+	Items             []GitHubRepository `json:"items"`
+}
+
+// GitHubRepository represents a single repository search result
+type GitHubRepository struct {
+	FullName        string         `json:"full_name"`
+	HTMLURL         string         `json:"html_url"`
+	Description     string         `json:"description"`
+	Language        string         `json:"language"`
+	StargazersCount int            `json:"stargazers_count"`
+	ForksCount      int            `json:"forks_count"`
+	OpenIssuesCount int            `json:"open_issues_count"`
+	Topics          []string       `json:"topics"`
+	Archived        bool           `json:"archived"`
+	PushedAt        string         `json:"pushed_at"`
+	License         *GitHubLicense `json:"license"`
+}
+
+// GitHubLicense represents a repository's detected licence
+type GitHubLicense struct {
+	SPDXID string `json:"spdx_id"`
+}
+
+// GitHubCodeSearchResponse represents the response from the GitHub code search API
+type GitHubCodeSearchResponse struct {
+	TotalCount        int              `json:"total_count"`
+	IncompleteResults bool             `json:"incomplete_results"`
+	Items             []GitHubCodeItem `json:"items"`
+}
+
+// GitHubCodeItem represents a single file matched by code search
+type GitHubCodeItem struct {
+	Name        string               `json:"name"`
+	Path        string               `json:"path"`
+	SHA         string               `json:"sha"`
+	HTMLURL     string               `json:"html_url"`
+	Repository  GitHubCodeRepository `json:"repository"`
+	TextMatches []GitHubTextMatch    `json:"text_matches"`
+}
+
+// GitHubCodeRepository is the repository summary included with code search results
+type GitHubCodeRepository struct {
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// GitHubTextMatch is a fragment of a file around a match, returned with the text-match media type
+type GitHubTextMatch struct {
+	Property string `json:"property"`
+	Fragment string `json:"fragment"`
+}
+
+// GitHubErrorResponse represents an error response from the GitHub API
+type GitHubErrorResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
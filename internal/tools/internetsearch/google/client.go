@@ -20,27 +20,40 @@ const (
 
 // GoogleClient handles communication with Google Custom Search API
 type GoogleClient struct {
-	apiKey string
-	cx     string // Custom Search Engine ID
-	client internetsearch.HTTPClientInterface
+	apiKey  string
+	cx      string // Custom Search Engine ID
+	baseURL string
+	client  internetsearch.HTTPClientInterface
+}
+
+// SearchOptions contains optional Google Custom Search parameters
+type SearchOptions struct {
+	Safe         string                      // "active" or "off"
+	SiteSearch   string                      // Restrict results to, or exclude results from, this site
+	SiteExclude  bool                        // Exclude SiteSearch rather than restricting to it
+	Domains      internetsearch.DomainFilter // Domains not covered by SiteSearch, applied as site: and -site: query operators
+	Country      string                      // Geolocation of the end user (gl), a lower-case country code
+	Language     string                      // Interface language (hl)
+	DateRestrict string                      // Restrict results by date, e.g. "d1", "w1", "m1", "y1"
 }
 
 // NewGoogleClient creates a new Google Custom Search API client
 func NewGoogleClient(apiKey, cx string) *GoogleClient {
 	return &GoogleClient{
-		apiKey: apiKey,
-		cx:     cx,
-		client: internetsearch.NewRateLimitedHTTPClient(),
+		apiKey:  apiKey,
+		cx:      cx,
+		baseURL: googleSearchAPIURL,
+		client:  internetsearch.NewRateLimitedHTTPClient(),
 	}
 }
 
 // Search performs a search query
-func (c *GoogleClient) Search(ctx context.Context, logger *logrus.Logger, query string, searchType string, count int, start int) (*GoogleSearchResponse, error) {
+func (c *GoogleClient) Search(ctx context.Context, logger *logrus.Logger, query string, searchType string, count int, start int, opts SearchOptions) (*GoogleSearchResponse, error) {
 	// Build query parameters
 	params := url.Values{}
 	params.Set("key", c.apiKey)
 	params.Set("cx", c.cx)
-	params.Set("q", query)
+	params.Set("q", opts.Domains.ExcludeOperators(opts.Domains.IncludeOperators(query)))
 	params.Set("num", fmt.Sprintf("%d", count))
 
 	if start > 0 {
@@ -52,10 +65,39 @@ func (c *GoogleClient) Search(ctx context.Context, logger *logrus.Logger, query
 		params.Set("searchType", "image")
 	}
 
-	requestURL := fmt.Sprintf("%s?%s", googleSearchAPIURL, params.Encode())
+	if opts.Safe != "" {
+		params.Set("safe", opts.Safe)
+	}
+
+	if opts.SiteSearch != "" {
+		params.Set("siteSearch", opts.SiteSearch)
+		if opts.SiteExclude {
+			params.Set("siteSearchFilter", "e")
+		} else {
+			params.Set("siteSearchFilter", "i")
+		}
+	}
+
+	if opts.Country != "" {
+		params.Set("gl", opts.Country)
+	}
+
+	if opts.Language != "" {
+		params.Set("hl", opts.Language)
+	}
+
+	if opts.DateRestrict != "" {
+		params.Set("dateRestrict", opts.DateRestrict)
+	}
+
+	parsedBaseURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Google API URL: %w", err)
+	}
+	requestURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
 
 	// Security check: verify domain access
-	if err := security.CheckDomainAccess("www.googleapis.com"); err != nil {
+	if err := security.CheckDomainAccess(parsedBaseURL.Hostname()); err != nil {
 		return nil, err
 	}
 
@@ -71,7 +113,10 @@ func (c *GoogleClient) Search(ctx context.Context, logger *logrus.Logger, query
 	// Execute request with rate limiting
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("search request failed: %w", err)
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, internetsearch.RedactURLError(err)))
 	}
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
@@ -82,21 +127,22 @@ func (c *GoogleClient) Search(ctx context.Context, logger *logrus.Logger, query
 	// Read response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response: %w", internetsearch.ErrNetwork, err))
 	}
+	internetsearch.CaptureResponse(ctx, logger, "google", resp, body)
 
 	// Check for errors
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("google API error: status %d, body: %s", resp.StatusCode, string(body))
+		return nil, translateAPIError(resp, body)
 	}
 
 	// Security analysis: check response content for threats
 	if security.IsEnabled() {
 		source := security.SourceContext{
 			Tool:        "internet_search",
-			Domain:      "www.googleapis.com",
+			Domain:      parsedBaseURL.Hostname(),
 			ContentType: "application/json",
-			URL:         requestURL,
+			URL:         c.baseURL,
 		}
 		if secResult, err := security.AnalyseContent(string(body), source); err == nil {
 			switch secResult.Action {
@@ -126,3 +172,42 @@ func (c *GoogleClient) Search(ctx context.Context, logger *logrus.Logger, query
 
 	return &result, nil
 }
+
+// translateAPIError converts a Google API error body into a descriptive error
+func translateAPIError(resp *http.Response, body []byte) error {
+	statusCode := resp.StatusCode
+	var errorResp GoogleErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err != nil || errorResp.Error.Message == "" {
+		return fmt.Errorf("google API error: status %d, body: %s", statusCode, string(body))
+	}
+
+	reasons := make([]string, 0, len(errorResp.Error.Errors)+len(errorResp.Error.Details))
+	for _, detail := range errorResp.Error.Errors {
+		reasons = append(reasons, detail.Reason)
+	}
+	for _, detail := range errorResp.Error.Details {
+		reasons = append(reasons, detail.Reason)
+	}
+
+	for _, reason := range reasons {
+		switch reason {
+		case "API_KEY_INVALID", "keyInvalid":
+			return fmt.Errorf("%w: google API key is invalid, check GOOGLE_SEARCH_API_KEY (%s)", internetsearch.ErrAuth, errorResp.Error.Message)
+		case "rateLimitExceeded", "userRateLimitExceeded":
+			return &internetsearch.RateLimitError{
+				Provider:   "google",
+				RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			}
+		case "dailyLimitExceeded", "quotaExceeded", "RATE_LIMIT_EXCEEDED":
+			return fmt.Errorf("google API %w: the daily query limit for this API key has been reached (%s)", internetsearch.ErrQuotaExceeded, errorResp.Error.Message)
+		case "accessNotConfigured", "SERVICE_DISABLED":
+			return fmt.Errorf("%w: google Custom Search API is not enabled for this project, enable it in the Google Cloud Console (%s)", internetsearch.ErrAuth, errorResp.Error.Message)
+		}
+	}
+
+	if errorResp.Error.Status == "RESOURCE_EXHAUSTED" {
+		return fmt.Errorf("google API %w: the daily query limit for this API key has been reached (%s)", internetsearch.ErrQuotaExceeded, errorResp.Error.Message)
+	}
+
+	return fmt.Errorf("google API error (%d): %s", statusCode, errorResp.Error.Message)
+}
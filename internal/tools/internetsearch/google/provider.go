@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
@@ -14,8 +16,18 @@ const (
 	// Google Custom Search API result limits
 	googleMinResults = 1
 	googleMaxResults = 10
+	// googleMaxAggregateResults is the maximum number of results the API will return across all pages
+	googleMaxAggregateResults = 100
 )
 
+// dateRestrictValues maps time ranges to Google's dateRestrict values
+var dateRestrictValues = map[string]string{
+	internetsearch.TimeRangeDay:   "d1",
+	internetsearch.TimeRangeWeek:  "w1",
+	internetsearch.TimeRangeMonth: "m1",
+	internetsearch.TimeRangeYear:  "y1",
+}
+
 // GoogleProvider implements the unified SearchProvider interface
 type GoogleProvider struct {
 	client *GoogleClient
@@ -24,7 +36,7 @@ type GoogleProvider struct {
 // NewGoogleProvider creates a new Google Custom Search provider
 func NewGoogleProvider() *GoogleProvider {
 	apiKey := os.Getenv("GOOGLE_SEARCH_API_KEY")
-	searchID := os.Getenv("GOOGLE_SEARCH_ID")
+	searchID := getSearchEngineID()
 
 	if apiKey == "" || searchID == "" {
 		return nil
@@ -35,6 +47,14 @@ func NewGoogleProvider() *GoogleProvider {
 	}
 }
 
+// getSearchEngineID returns the Custom Search Engine ID from GOOGLE_SEARCH_CX, falling back to GOOGLE_SEARCH_ID
+func getSearchEngineID() string {
+	if cx := os.Getenv("GOOGLE_SEARCH_CX"); cx != "" {
+		return cx
+	}
+	return os.Getenv("GOOGLE_SEARCH_ID")
+}
+
 // GetName returns the provider name
 func (p *GoogleProvider) GetName() string {
 	return "google"
@@ -42,7 +62,7 @@ func (p *GoogleProvider) GetName() string {
 
 // IsAvailable checks if the provider is available
 func (p *GoogleProvider) IsAvailable() bool {
-	return p.client != nil && os.Getenv("GOOGLE_SEARCH_API_KEY") != "" && os.Getenv("GOOGLE_SEARCH_ID") != ""
+	return p.client != nil && os.Getenv("GOOGLE_SEARCH_API_KEY") != "" && getSearchEngineID() != ""
 }
 
 // GetSupportedTypes returns the search types this provider supports
@@ -50,6 +70,14 @@ func (p *GoogleProvider) GetSupportedTypes() []string {
 	return []string{"web", "image"}
 }
 
+// Describe returns how the provider is configured, for provider listings
+func (p *GoogleProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "Google Custom Search JSON API with web and image search; GOOGLE_SEARCH_ID is read when GOOGLE_SEARCH_CX is unset",
+		RequiredEnvVars: []string{"GOOGLE_SEARCH_API_KEY", "GOOGLE_SEARCH_CX"},
+	}
+}
+
 // extractQuery safely extracts and validates the query parameter
 func (p *GoogleProvider) extractQuery(args map[string]any) (string, error) {
 	queryVal, exists := args["query"]
@@ -82,29 +110,48 @@ func (p *GoogleProvider) Search(ctx context.Context, logger *logrus.Logger, sear
 		"query":    query,
 	}).Debug("Google search parameters")
 
+	region, err := internetsearch.ParseRegion(args)
+	if err != nil {
+		return nil, err
+	}
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := p.parseSearchOptions(args, region, timeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *internetsearch.SearchResponse
 	switch searchType {
 	case "web":
-		return p.executeInternetSearch(ctx, logger, args)
+		response, err = p.executeInternetSearch(ctx, logger, args, opts)
 	case "image":
-		return p.executeImageSearch(ctx, logger, args)
+		response, err = p.executeImageSearch(ctx, logger, args, opts)
 	default:
 		return nil, fmt.Errorf("unsupported search type for Google: %s", searchType)
 	}
-}
-
-// executeInternetSearch handles internet search for web results
-func (p *GoogleProvider) executeInternetSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
-	query, err := p.extractQuery(args)
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse optional parameters
+	if region != nil {
+		response.Region = region.Code
+	}
+	response.ApplyTimeRange(timeRange, true)
+	return response, nil
+}
+
+// parsePaging parses and validates the count and start parameters
+func (p *GoogleProvider) parsePaging(args map[string]any) (int, int, error) {
 	count := googleMaxResults
 	if countRaw, ok := args["count"].(float64); ok {
 		count = int(countRaw)
-		if count < googleMinResults || count > googleMaxResults {
-			return nil, fmt.Errorf("count must be between %d and %d for Google search, got %d", googleMinResults, googleMaxResults, count)
+		if count < googleMinResults || count > googleMaxAggregateResults {
+			return 0, 0, fmt.Errorf("count must be between %d and %d for Google search, got %d", googleMinResults, googleMaxAggregateResults, count)
 		}
 	}
 
@@ -112,26 +159,143 @@ func (p *GoogleProvider) executeInternetSearch(ctx context.Context, logger *logr
 	if startRaw, ok := args["start"].(float64); ok {
 		start = int(startRaw)
 		if start < 0 {
-			return nil, fmt.Errorf("start must be >= 0, got %d", start)
+			return 0, 0, fmt.Errorf("start must be >= 0, got %d", start)
+		}
+	}
+
+	return count, start, nil
+}
+
+// parseSearchOptions parses the optional safe search and domain restriction parameters and applies the region and time range
+func (p *GoogleProvider) parseSearchOptions(args map[string]any, region *internetsearch.Region, timeRange string) (SearchOptions, error) {
+	var opts SearchOptions
+
+	if region != nil {
+		opts.Country = strings.ToLower(region.Country)
+		opts.Language = region.Language
+	}
+
+	if timeRange != "" {
+		opts.DateRestrict = dateRestrictValues[timeRange]
+	}
+
+	// Google only distinguishes active and off, moderate keeps Google's default
+	safeSearch, err := internetsearch.ParseSafeSearch(args)
+	if err != nil {
+		return opts, err
+	}
+	switch safeSearch {
+	case internetsearch.SafeSearchStrict:
+		opts.Safe = "active"
+	case internetsearch.SafeSearchOff:
+		opts.Safe = "off"
+	}
+
+	// The Google-specific safe parameter takes precedence
+	if safeRaw, ok := args["safe"].(string); ok && safeRaw != "" {
+		if safeRaw != "active" && safeRaw != "off" {
+			return opts, fmt.Errorf("safe must be 'active' or 'off' for Google search, got %q", safeRaw)
+		}
+		opts.Safe = safeRaw
+	}
+
+	domains, err := internetsearch.ParseDomainFilter(args)
+	if err != nil {
+		return opts, err
+	}
+	if siteRaw, ok := args["site"].(string); ok {
+		if site := strings.TrimSpace(siteRaw); site != "" && !slices.Contains(domains.Include, site) {
+			domains.Include = append([]string{site}, domains.Include...)
+		}
+	}
+
+	// siteSearch takes a single site, so it's used when only one domain is given and
+	// anything more falls back to query operators
+	switch {
+	case len(domains.Include) == 1 && len(domains.Exclude) == 0:
+		opts.SiteSearch = domains.Include[0]
+	case len(domains.Include) == 0 && len(domains.Exclude) == 1:
+		opts.SiteSearch = domains.Exclude[0]
+		opts.SiteExclude = true
+	default:
+		opts.Domains = domains
+	}
+
+	return opts, nil
+}
+
+// searchPages fetches as many pages as needed to satisfy count, as the API returns at most 10 results per request
+func (p *GoogleProvider) searchPages(ctx context.Context, logger *logrus.Logger, query, searchType string, count, start int, opts SearchOptions) ([]GoogleSearchResult, internetsearch.PageStats, error) {
+	items := make([]GoogleSearchResult, 0, count)
+	// The API's start parameter is 1-based
+	nextStart := max(start, 1)
+	if nextStart > googleMaxAggregateResults {
+		return items, internetsearch.PageStats{RequestedCount: count}, nil
+	}
+
+	stats, err := internetsearch.FetchPages(logger, "google", count, googleMaxAggregateResults/googleMaxResults, func(page, remaining int) (int, bool, error) {
+		pageSize := min(remaining, googleMaxResults, googleMaxAggregateResults-nextStart+1)
+
+		// Preserve the original request shape for single-page searches without an explicit start
+		pageStart := nextStart
+		if start == 0 && page == 0 {
+			pageStart = 0
+		}
+
+		response, err := p.client.Search(ctx, logger, query, searchType, pageSize, pageStart, opts)
+		if err != nil {
+			return 0, false, err
 		}
+
+		items = append(items, response.Items...)
+		nextStart += len(response.Items)
+
+		// Stop when the API has no further pages
+		more := len(response.Items) == pageSize && len(response.Queries.NextPage) > 0 && nextStart <= googleMaxAggregateResults
+		return len(response.Items), more, nil
+	})
+	if err != nil {
+		return nil, stats, err
 	}
 
-	response, err := p.client.Search(ctx, logger, query, "web", count, start)
+	if len(items) > count {
+		items = items[:count]
+	}
+
+	return items, stats, nil
+}
+
+// executeInternetSearch handles internet search for web results
+func (p *GoogleProvider) executeInternetSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts SearchOptions) (*internetsearch.SearchResponse, error) {
+	query, err := p.extractQuery(args)
+	if err != nil {
+		return nil, err
+	}
+
+	count, start, err := p.parsePaging(args)
+	if err != nil {
+		return nil, err
+	}
+
+	items, stats, err := p.searchPages(ctx, logger, query, "web", count, start, opts)
 	if err != nil {
 		return nil, fmt.Errorf("internet search failed: %w", err)
 	}
 
 	// Convert to unified format
-	if len(response.Items) == 0 {
-		return p.createEmptyResponse()
+	if len(items) == 0 {
+		response, _ := p.createEmptyResponse()
+		stats.Apply(response)
+		return response, nil
 	}
 
-	results := make([]internetsearch.SearchResult, 0, len(response.Items))
-	for _, item := range response.Items {
+	results := make([]internetsearch.SearchResult, 0, len(items))
+	for _, item := range items {
 		metadata := make(map[string]any)
 		if item.DisplayLink != "" {
 			metadata["displayLink"] = item.DisplayLink
 		}
+		addThumbnailMetadata(metadata, item.PageMap)
 
 		results = append(results, internetsearch.SearchResult{
 			Title:       item.Title,
@@ -141,46 +305,41 @@ func (p *GoogleProvider) executeInternetSearch(ctx context.Context, logger *logr
 		})
 	}
 
-	return p.createSuccessResponse(query, results, logger)
+	response, _ := p.createSuccessResponse(query, results, logger)
+	stats.Apply(response)
+	return response, nil
 }
 
 // executeImageSearch handles image search
-func (p *GoogleProvider) executeImageSearch(ctx context.Context, logger *logrus.Logger, args map[string]any) (*internetsearch.SearchResponse, error) {
+func (p *GoogleProvider) executeImageSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, opts SearchOptions) (*internetsearch.SearchResponse, error) {
 	query, err := p.extractQuery(args)
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse optional parameters
-	count := googleMaxResults
-	if countRaw, ok := args["count"].(float64); ok {
-		count = int(countRaw)
-		if count < googleMinResults || count > googleMaxResults {
-			return nil, fmt.Errorf("count must be between %d and %d for Google image search, got %d", googleMinResults, googleMaxResults, count)
-		}
-	}
-
-	start := 0
-	if startRaw, ok := args["start"].(float64); ok {
-		start = int(startRaw)
-		if start < 0 {
-			return nil, fmt.Errorf("start must be >= 0, got %d", start)
-		}
+	count, start, err := p.parsePaging(args)
+	if err != nil {
+		return nil, err
 	}
 
-	response, err := p.client.Search(ctx, logger, query, "image", count, start)
+	items, stats, err := p.searchPages(ctx, logger, query, "image", count, start, opts)
 	if err != nil {
 		return nil, fmt.Errorf("image search failed: %w", err)
 	}
 
 	// Convert to unified format
-	if len(response.Items) == 0 {
-		return p.createEmptyResponse()
+	if len(items) == 0 {
+		response, _ := p.createEmptyResponse()
+		stats.Apply(response)
+		return response, nil
 	}
 
-	results := make([]internetsearch.SearchResult, 0, len(response.Items))
-	for _, item := range response.Items {
+	results := make([]internetsearch.SearchResult, 0, len(items))
+	for _, item := range items {
 		metadata := make(map[string]any)
+		if item.DisplayLink != "" {
+			metadata["displayLink"] = item.DisplayLink
+		}
 
 		// Add image-specific metadata
 		if item.Image != nil {
@@ -212,7 +371,26 @@ func (p *GoogleProvider) executeImageSearch(ctx context.Context, logger *logrus.
 		})
 	}
 
-	return p.createSuccessResponse(query, results, logger)
+	response, _ := p.createSuccessResponse(query, results, logger)
+	stats.Apply(response)
+	return response, nil
+}
+
+// addThumbnailMetadata adds pagemap thumbnail data to the result metadata when present
+func addThumbnailMetadata(metadata map[string]any, pageMap *GooglePageMap) {
+	if pageMap == nil || len(pageMap.CSEThumbnail) == 0 || pageMap.CSEThumbnail[0].Src == "" {
+		return
+	}
+
+	thumbnail := pageMap.CSEThumbnail[0]
+	thumbnailInfo := map[string]any{"url": thumbnail.Src}
+	if thumbnail.Width != "" {
+		thumbnailInfo["width"] = thumbnail.Width
+	}
+	if thumbnail.Height != "" {
+		thumbnailInfo["height"] = thumbnail.Height
+	}
+	metadata["thumbnail"] = thumbnailInfo
 }
 
 // Helper functions
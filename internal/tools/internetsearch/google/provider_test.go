@@ -0,0 +1,357 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+func newTestProvider(server *httptest.Server) *GoogleProvider {
+	return &GoogleProvider{
+		client: &GoogleClient{
+			apiKey:  "test-key",
+			cx:      "test-cx",
+			baseURL: server.URL,
+			client:  server.Client(),
+		},
+	}
+}
+
+func TestGoogleProvider_PaginationStitching(t *testing.T) {
+	pages := map[string]string{
+		"":   "web_page1.json",
+		"11": "web_page2.json",
+		"21": "web_page3.json",
+	}
+
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		fixture, ok := pages[r.URL.Query().Get("start")]
+		if !ok {
+			t.Errorf("Unexpected start parameter: %s", r.URL.Query().Get("start"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(testutils.ReadFixture(t, fixture))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query": "golang",
+		"count": float64(25),
+		"safe":  "active",
+		"site":  "example.com",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("Expected 3 page requests, got %d", len(requests))
+	}
+	if requests[1].URL.Query().Get("num") != "10" || requests[2].URL.Query().Get("num") != "5" {
+		t.Errorf("Unexpected page sizes: %s, %s", requests[1].URL.Query().Get("num"), requests[2].URL.Query().Get("num"))
+	}
+	for _, req := range requests {
+		query := req.URL.Query()
+		if query.Get("safe") != "active" || query.Get("siteSearch") != "example.com" || query.Get("siteSearchFilter") != "i" {
+			t.Errorf("Expected safe and site restriction on every page, got %v", query)
+		}
+	}
+
+	// The final page only has 3 results, so we get 23 rather than 25
+	if len(response.Results) != 23 {
+		t.Fatalf("Expected 23 stitched results, got %d", len(response.Results))
+	}
+	if response.Results[0].Title != "Result 1" || response.Results[22].Title != "Result 23" {
+		t.Errorf("Results not stitched in order: first %q, last %q", response.Results[0].Title, response.Results[22].Title)
+	}
+	if response.Metadata["requested_count"] != 25 || response.Metadata["result_count"] != 23 || response.Metadata["pages_fetched"] != 3 {
+		t.Errorf("Unexpected paging metadata: %v", response.Metadata)
+	}
+
+	metadata := response.Results[0].Metadata
+	if metadata["displayLink"] != "example.com" {
+		t.Errorf("Expected displayLink metadata, got %v", metadata["displayLink"])
+	}
+	thumbnail, ok := metadata["thumbnail"].(map[string]any)
+	if !ok || thumbnail["url"] != "https://img.example.com/1.png" {
+		t.Errorf("Expected thumbnail metadata, got %v", metadata["thumbnail"])
+	}
+}
+
+func TestGoogleProvider_SinglePageRequest(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.URL.Query().Get("start") != "" {
+			t.Errorf("Expected no start parameter on a single page request")
+		}
+		_, _ = w.Write(testutils.ReadFixture(t, "web_page1.json"))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query": "golang",
+		"count": float64(5),
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected 1 request, got %d", requestCount)
+	}
+	if len(response.Results) != 5 {
+		t.Errorf("Expected results to be trimmed to 5, got %d", len(response.Results))
+	}
+}
+
+func TestGoogleProvider_APIErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		status   int
+		expected string
+	}{
+		{name: "quota exceeded", fixture: "quota_exceeded.json", status: http.StatusTooManyRequests, expected: "quota exceeded"},
+		{name: "invalid key", fixture: "invalid_key.json", status: http.StatusBadRequest, expected: "API key is invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write(testutils.ReadFixture(t, tt.fixture))
+			}))
+			defer server.Close()
+
+			provider := newTestProvider(server)
+			_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+			if err == nil {
+				t.Fatal("Expected error, got success")
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestGoogleProvider_ErrorClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write(testutils.ReadFixture(t, "rate_limited.json"))
+	}))
+	provider := newTestProvider(server)
+
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+	var rateLimitErr *internetsearch.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf("Expected a rate limit error with Retry-After, got %v", err)
+	}
+	if errors.Is(err, internetsearch.ErrQuotaExceeded) {
+		t.Errorf("Expected a short-term rate limit not to be reported as quota exhaustion, got %v", err)
+	}
+
+	// A server that can't be reached is a retryable network error
+	server.Close()
+	_, err = provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+	if !errors.Is(err, internetsearch.ErrNetwork) || !internetsearch.IsRetryable(err) {
+		t.Errorf("Expected a retryable network error, got %v", err)
+	}
+}
+
+func TestGoogleProvider_InvalidParameters(t *testing.T) {
+	provider := &GoogleProvider{client: NewGoogleClient("test-key", "test-cx")}
+
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang", "count": float64(101)}); err == nil {
+		t.Error("Expected error for count > 100")
+	}
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang", "safe": "medium"}); err == nil {
+		t.Error("Expected error for invalid safe value")
+	}
+}
+
+func TestGoogleProvider_Region(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write(testutils.ReadFixture(t, "web_page1.json"))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":  "golang",
+		"count":  float64(5),
+		"region": "uk-en",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if got := query["gl"]; len(got) != 1 || got[0] != "gb" {
+		t.Errorf("Expected gl=gb, got %v", got)
+	}
+	if got := query["hl"]; len(got) != 1 || got[0] != "en" {
+		t.Errorf("Expected hl=en, got %v", got)
+	}
+	if response.Region != "uk-en" {
+		t.Errorf("Expected effective region to be recorded, got %q", response.Region)
+	}
+
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang", "region": "gb"}); err == nil {
+		t.Error("Expected error for invalid region")
+	}
+}
+
+func TestGoogleProvider_SafeSearch(t *testing.T) {
+	tests := []struct {
+		args     map[string]any
+		expected string
+	}{
+		{args: map[string]any{}, expected: ""},
+		{args: map[string]any{"safesearch": "moderate"}, expected: ""},
+		{args: map[string]any{"safesearch": "strict"}, expected: "active"},
+		{args: map[string]any{"safesearch": "off"}, expected: "off"},
+		// The Google-specific parameter wins over the unified one
+		{args: map[string]any{"safesearch": "strict", "safe": "off"}, expected: "off"},
+	}
+
+	for _, tt := range tests {
+		var received string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.URL.Query().Get("safe")
+			_, _ = w.Write(testutils.ReadFixture(t, "web_page1.json"))
+		}))
+
+		tt.args["query"] = "golang"
+		tt.args["count"] = float64(5)
+		_, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "web", tt.args)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Args %v: expected success, got error: %v", tt.args, err)
+		}
+		if received != tt.expected {
+			t.Errorf("Args %v: expected safe=%q, got %q", tt.args, tt.expected, received)
+		}
+	}
+}
+
+func TestGoogleProvider_TimeRangeWithPaging(t *testing.T) {
+	var queries []map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_, _ = w.Write(testutils.ReadFixture(t, "web_page2.json"))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":      "golang",
+		"count":      float64(5),
+		"start":      float64(11),
+		"region":     "de-de",
+		"time_range": "month",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if len(queries) == 0 {
+		t.Fatal("Expected a request to be made")
+	}
+	query := queries[0]
+	expected := map[string]string{"dateRestrict": "m1", "start": "11", "gl": "de", "hl": "de", "num": "5"}
+	for key, value := range expected {
+		if got := query[key]; len(got) != 1 || got[0] != value {
+			t.Errorf("Expected %s=%s, got %v", key, value, got)
+		}
+	}
+	if response.TimeRange != "month" {
+		t.Errorf("Expected applied time range to be echoed, got %q", response.TimeRange)
+	}
+
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang", "time_range": "fortnight"}); err == nil {
+		t.Error("Expected error for invalid time_range")
+	}
+}
+
+func TestGoogleProvider_DomainFilters(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       map[string]any
+		query      string
+		siteSearch string
+		filter     string
+	}{
+		{
+			name:       "single include uses siteSearch",
+			args:       map[string]any{"include_domains": []any{"www.go.dev"}},
+			query:      "golang",
+			siteSearch: "go.dev",
+			filter:     "i",
+		},
+		{
+			name:       "single exclude uses siteSearch",
+			args:       map[string]any{"exclude_domains": []any{"pinterest.com"}},
+			query:      "golang",
+			siteSearch: "pinterest.com",
+			filter:     "e",
+		},
+		{
+			name:  "multiple domains use query operators",
+			args:  map[string]any{"include_domains": []any{"go.dev", "github.com"}, "exclude_domains": []any{"pinterest.com"}},
+			query: "golang (site:go.dev OR site:github.com) -site:pinterest.com",
+		},
+		{
+			name:  "site combines with include domains",
+			args:  map[string]any{"site": "go.dev", "include_domains": []any{"github.com"}},
+			query: "golang (site:go.dev OR site:github.com)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query map[string][]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = w.Write(testutils.ReadFixture(t, "web_page2.json"))
+			}))
+			defer server.Close()
+
+			args := map[string]any{"query": "golang", "count": float64(5)}
+			for key, value := range tt.args {
+				args[key] = value
+			}
+			if _, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "web", args); err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+
+			get := func(key string) string {
+				if values := query[key]; len(values) > 0 {
+					return values[0]
+				}
+				return ""
+			}
+			if get("q") != tt.query {
+				t.Errorf("Expected q=%q, got %q", tt.query, get("q"))
+			}
+			if get("siteSearch") != tt.siteSearch || get("siteSearchFilter") != tt.filter {
+				t.Errorf("Expected siteSearch=%q siteSearchFilter=%q, got %q %q", tt.siteSearch, tt.filter, get("siteSearch"), get("siteSearchFilter"))
+			}
+		})
+	}
+}
@@ -73,3 +73,24 @@ type GoogleSearchInfo struct {
 	TotalResults          string  `json:"totalResults,omitempty"`
 	FormattedTotalResults string  `json:"formattedTotalResults,omitempty"`
 }
+
+// GoogleErrorResponse represents an error response from the Google API
+type GoogleErrorResponse struct {
+	Error GoogleErrorBody `json:"error"`
+}
+
+// GoogleErrorBody contains the details of a Google API error
+type GoogleErrorBody struct {
+	Code    int                 `json:"code"`
+	Message string              `json:"message"`
+	Status  string              `json:"status,omitempty"`
+	Errors  []GoogleErrorDetail `json:"errors,omitempty"`
+	Details []GoogleErrorDetail `json:"details,omitempty"`
+}
+
+// GoogleErrorDetail contains the reason for a Google API error
+type GoogleErrorDetail struct {
+	Message string `json:"message,omitempty"`
+	Domain  string `json:"domain,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
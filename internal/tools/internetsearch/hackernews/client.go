@@ -0,0 +1,162 @@
+package hackernews
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// HackerNewsAPIBaseURL is the base URL for the Algolia Hacker News search API
+	HackerNewsAPIBaseURL = "https://hn.algolia.com/api/v1"
+
+	// UserAgent for API requests
+	UserAgent = "mcp-devtools/1.0"
+)
+
+// HackerNewsSearchRequest holds the parameters for a search
+type HackerNewsSearchRequest struct {
+	Query          string
+	Tags           string
+	NumericFilters string
+	HitsPerPage    int
+	ByDate         bool // Use the search_by_date endpoint, newest first, rather than relevance ranking
+}
+
+// HackerNewsClient handles HTTP requests to the Algolia Hacker News API
+type HackerNewsClient struct {
+	httpClient internetsearch.HTTPClientInterface
+	baseURL    string
+}
+
+// NewHackerNewsClient creates a new Hacker News API client with rate limiting
+func NewHackerNewsClient() *HackerNewsClient {
+	return &HackerNewsClient{
+		baseURL:    HackerNewsAPIBaseURL,
+		httpClient: internetsearch.NewRateLimitedHTTPClient(),
+	}
+}
+
+// Search performs a search using the Algolia Hacker News API
+func (c *HackerNewsClient) Search(ctx context.Context, logger *logrus.Logger, request HackerNewsSearchRequest) (*HackerNewsSearchResponse, error) {
+	endpoint := "/search"
+	if request.ByDate {
+		endpoint = "/search_by_date"
+	}
+
+	reqURL, err := url.Parse(c.baseURL + endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Check domain access security for API endpoint using security helper
+	if err := security.CheckDomainAccess(reqURL.Hostname()); err != nil {
+		if secErr, ok := err.(*security.SecurityError); ok {
+			return nil, security.FormatSecurityBlockError(secErr)
+		}
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("query", request.Query)
+	params.Set("hitsPerPage", strconv.Itoa(request.HitsPerPage))
+	if request.Tags != "" {
+		params.Set("tags", request.Tags)
+	}
+	if request.NumericFilters != "" {
+		params.Set("numericFilters", request.NumericFilters)
+	}
+	reqURL.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", UserAgent)
+
+	logger.WithFields(logrus.Fields{
+		"url":     reqURL.String(),
+		"tags":    request.Tags,
+		"by_date": request.ByDate,
+	}).Debug("Making Hacker News API request")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return nil, fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("Failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read response body: %w", internetsearch.ErrNetwork, err)
+	}
+	internetsearch.CaptureResponse(ctx, logger, "hackernews", resp, body)
+
+	// Security analysis on content
+	if security.IsEnabled() {
+		sourceCtx := security.SourceContext{
+			URL:         reqURL.String(),
+			Domain:      reqURL.Hostname(),
+			ContentType: resp.Header.Get("Content-Type"),
+			Tool:        "internetsearch",
+		}
+
+		if secResult, err := security.AnalyseContent(string(body), sourceCtx); err == nil {
+			switch secResult.Action {
+			case security.ActionBlock:
+				return nil, security.FormatSecurityBlockErrorFromResult(secResult)
+			case security.ActionWarn:
+				logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
+			}
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.translateError(logger, resp, body)
+	}
+
+	var response HackerNewsSearchResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse search response: %w", internetsearch.ErrParse, err)
+	}
+
+	return &response, nil
+}
+
+// translateError converts an Algolia error response into a descriptive error
+func (c *HackerNewsClient) translateError(logger *logrus.Logger, resp *http.Response, body []byte) error {
+	logger.WithFields(logrus.Fields{
+		"status_code": resp.StatusCode,
+		"status":      resp.Status,
+	}).Error("Hacker News API request failed")
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &internetsearch.RateLimitError{
+			Provider:   "hackernews",
+			RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	var errorResp HackerNewsErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+		return fmt.Errorf("hacker news API error (%d): %s", resp.StatusCode, errorResp.Message)
+	}
+	return fmt.Errorf("hacker news API request failed with status %d: %s", resp.StatusCode, string(body))
+}
@@ -0,0 +1,250 @@
+package hackernews
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Hacker News result limits, Algolia allows more but larger pages add little for an assistant
+	hackerNewsMinResults = 1
+	hackerNewsMaxResults = 50
+
+	// hackerNewsItemURL is the discussion page for a story or comment ID
+	hackerNewsItemURL = "https://news.ycombinator.com/item?id="
+
+	// hackerNewsMaxDescription caps the length of story and comment text used as a description
+	hackerNewsMaxDescription = 500
+)
+
+// hackerNewsItemTypes maps the hn_item_type argument to Algolia tag filters
+var hackerNewsItemTypes = map[string]string{
+	"story":   "story",
+	"comment": "comment",
+	"ask_hn":  "ask_hn",
+	"show_hn": "show_hn",
+	"all":     "(story,comment)",
+}
+
+// htmlTagPattern matches the HTML tags Algolia leaves in story and comment text
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// HackerNewsProvider implements the unified SearchProvider interface
+type HackerNewsProvider struct {
+	client *HackerNewsClient
+}
+
+// NewHackerNewsProvider creates a new Hacker News search provider
+func NewHackerNewsProvider() *HackerNewsProvider {
+	return &HackerNewsProvider{
+		client: NewHackerNewsClient(),
+	}
+}
+
+// GetName returns the provider name
+func (p *HackerNewsProvider) GetName() string {
+	return "hackernews"
+}
+
+// IsAvailable checks if the provider is available (always true as the API needs no key)
+func (p *HackerNewsProvider) IsAvailable() bool {
+	return p.client != nil
+}
+
+// GetSupportedTypes returns the search types this provider supports
+func (p *HackerNewsProvider) GetSupportedTypes() []string {
+	return []string{"web", "news"}
+}
+
+// Describe returns how the provider is configured, for provider listings
+func (p *HackerNewsProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary: "Hacker News stories and comments via the Algolia API, only used when requested by name",
+	}
+}
+
+// Search executes a search using the Hacker News provider. Web searches rank by relevance and
+// news searches by date unless sort is given.
+func (p *HackerNewsProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	logger.WithFields(logrus.Fields{
+		"provider": "hackernews",
+		"type":     searchType,
+		"query":    query,
+	}).Debug("Hacker News search parameters")
+
+	var defaultSort string
+	switch searchType {
+	case "web":
+		defaultSort = internetsearch.SortRelevance
+	case "news":
+		defaultSort = internetsearch.SortDate
+	default:
+		return nil, fmt.Errorf("unsupported search type for Hacker News: %s", searchType)
+	}
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := buildRequest(args, defaultSort, timeRange, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Search(ctx, logger, request)
+	if err != nil {
+		return nil, fmt.Errorf("hacker news search failed: %w", err)
+	}
+
+	results := make([]internetsearch.SearchResult, 0, len(response.Hits))
+	for i, hit := range response.Hits {
+		if hit.ObjectID == "" {
+			continue
+		}
+		result := convertHit(hit)
+		result.Metadata["position"] = i + 1
+		results = append(results, result)
+	}
+
+	searchResponse := p.createSuccessResponse(query, results, logger)
+	searchResponse.ApplyTimeRange(timeRange, true)
+	return searchResponse, nil
+}
+
+// buildRequest maps the search arguments to Algolia parameters
+func buildRequest(args map[string]any, defaultSort, timeRange string, now time.Time) (HackerNewsSearchRequest, error) {
+	request := HackerNewsSearchRequest{
+		Query:       args["query"].(string),
+		HitsPerPage: 10,
+	}
+
+	if countRaw, ok := args["count"].(float64); ok {
+		request.HitsPerPage = int(countRaw)
+		if request.HitsPerPage < hackerNewsMinResults || request.HitsPerPage > hackerNewsMaxResults {
+			return HackerNewsSearchRequest{}, fmt.Errorf("count must be between %d and %d for Hacker News search, got %d", hackerNewsMinResults, hackerNewsMaxResults, request.HitsPerPage)
+		}
+	}
+
+	itemType := "story"
+	if itemTypeRaw, ok := args["hn_item_type"].(string); ok && itemTypeRaw != "" {
+		itemType = itemTypeRaw
+	}
+	tags, ok := hackerNewsItemTypes[itemType]
+	if !ok {
+		return HackerNewsSearchRequest{}, fmt.Errorf("hn_item_type must be one of story, comment, ask_hn, show_hn or all, got %q", itemType)
+	}
+	request.Tags = tags
+
+	sort, err := internetsearch.ParseSort(args, defaultSort)
+	if err != nil {
+		return HackerNewsSearchRequest{}, err
+	}
+	request.ByDate = sort == internetsearch.SortDate
+
+	if start := internetsearch.TimeRangeStart(timeRange, now); !start.IsZero() {
+		request.NumericFilters = fmt.Sprintf("created_at_i>%d", start.Unix())
+	}
+
+	return request, nil
+}
+
+// convertHit maps a story or comment to a result linking to its Hacker News discussion page,
+// with the linked article, if any, recorded as article_url
+func convertHit(hit HackerNewsHit) internetsearch.SearchResult {
+	metadata := map[string]any{
+		"hn_id":  hit.ObjectID,
+		"author": hit.Author,
+	}
+	if hit.Points != nil {
+		metadata["points"] = *hit.Points
+	}
+	if hit.NumComments != nil {
+		metadata["comment_count"] = *hit.NumComments
+	}
+	if hit.CreatedAtI > 0 {
+		metadata["published"] = time.Unix(hit.CreatedAtI, 0).UTC().Format(time.RFC3339)
+	}
+
+	result := internetsearch.SearchResult{
+		URL:      hackerNewsItemURL + hit.ObjectID,
+		Metadata: metadata,
+	}
+
+	if hit.CommentText != "" {
+		metadata["item_type"] = "comment"
+		if hit.StoryID != nil {
+			metadata["story_id"] = *hit.StoryID
+		}
+		if hit.StoryURL != "" {
+			metadata["article_url"] = hit.StoryURL
+		}
+		result.Title = "Comment on: " + hit.StoryTitle
+		result.Description = cleanText(hit.CommentText)
+		return result
+	}
+
+	metadata["item_type"] = "story"
+	if hit.URL != "" {
+		metadata["article_url"] = hit.URL
+	}
+	result.Title = hit.Title
+	result.Description = cleanText(hit.StoryText)
+	if result.Description == "" {
+		result.Description = storySummary(hit)
+	}
+	return result
+}
+
+// storySummary describes a link story, which has no text of its own
+func storySummary(hit HackerNewsHit) string {
+	var parts []string
+	if hit.Points != nil {
+		parts = append(parts, fmt.Sprintf("%d points", *hit.Points))
+	}
+	if hit.Author != "" {
+		parts = append(parts, "by "+hit.Author)
+	}
+	summary := strings.Join(parts, " ")
+	if hit.NumComments != nil {
+		summary += fmt.Sprintf(", %d comments", *hit.NumComments)
+	}
+	return strings.TrimPrefix(summary, ", ")
+}
+
+// cleanText converts story or comment HTML to plain text, shortened to hackerNewsMaxDescription characters
+func cleanText(text string) string {
+	text = html.UnescapeString(htmlTagPattern.ReplaceAllString(text, " "))
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) > hackerNewsMaxDescription {
+		text = string([]rune(text)[:hackerNewsMaxDescription]) + "…"
+	}
+	return text
+}
+
+// Helper functions
+func (p *HackerNewsProvider) createSuccessResponse(query string, results []internetsearch.SearchResult, logger *logrus.Logger) *internetsearch.SearchResponse {
+	result := &internetsearch.SearchResponse{
+		Results:   results,
+		Provider:  "hackernews",
+		Timestamp: time.Now(),
+	}
+
+	logger.WithFields(logrus.Fields{
+		"query":        query,
+		"result_count": len(results),
+		"provider":     "hackernews",
+	}).Info("Hacker News search completed successfully")
+
+	return result
+}
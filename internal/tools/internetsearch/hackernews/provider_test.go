@@ -0,0 +1,263 @@
+package hackernews
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const hackerNewsSearchFixture = `{
+  "hits": [
+    {
+      "objectID": "38833143",
+      "title": "Go 1.22 Release Notes",
+      "url": "https://go.dev/doc/go1.22",
+      "author": "gopher",
+      "points": 412,
+      "num_comments": 187,
+      "story_text": null,
+      "created_at": "2024-02-06T18:00:00.000Z",
+      "created_at_i": 1707242400,
+      "_tags": ["story", "author_gopher", "story_38833143"]
+    },
+    {
+      "objectID": "38900001",
+      "title": "Ask HN: How do you structure large Go services?",
+      "url": null,
+      "author": "asker",
+      "points": 95,
+      "num_comments": 64,
+      "story_text": "<p>We&#x27;re growing past 50 packages.<p>What layouts have worked for you?",
+      "created_at": "2024-02-10T09:30:00.000Z",
+      "created_at_i": 1707557400,
+      "_tags": ["story", "author_asker", "story_38900001", "ask_hn"]
+    },
+    {
+      "objectID": "38833500",
+      "author": "reviewer",
+      "points": null,
+      "num_comments": null,
+      "comment_text": "The range-over-int change is <i>small</i> but welcome.",
+      "story_id": 38833143,
+      "story_title": "Go 1.22 Release Notes",
+      "story_url": "https://go.dev/doc/go1.22",
+      "created_at": "2024-02-06T19:00:00.000Z",
+      "created_at_i": 1707246000,
+      "_tags": ["comment", "author_reviewer", "story_38833143"]
+    }
+  ],
+  "nbHits": 3,
+  "page": 0,
+  "nbPages": 1,
+  "hitsPerPage": 10
+}`
+
+func newTestProvider(server *httptest.Server) *HackerNewsProvider {
+	return &HackerNewsProvider{
+		client: &HackerNewsClient{
+			baseURL:    server.URL,
+			httpClient: server.Client(),
+		},
+	}
+}
+
+func TestHackerNewsProvider_Results(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(hackerNewsSearchFixture))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":        "golang",
+		"count":        float64(3),
+		"hn_item_type": "all",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.URL.Path != "/search" {
+		t.Errorf("Expected the relevance endpoint for web search, got %s", received.URL.Path)
+	}
+	query := received.URL.Query()
+	if query.Get("query") != "golang" || query.Get("hitsPerPage") != "3" || query.Get("tags") != "(story,comment)" {
+		t.Errorf("Unexpected query parameters: %v", query)
+	}
+	if query.Has("numericFilters") {
+		t.Errorf("Expected no numericFilters without a time range, got %q", query.Get("numericFilters"))
+	}
+
+	if response.Provider != "hackernews" || len(response.Results) != 3 {
+		t.Fatalf("Expected 3 hackernews results, got %d from %q", len(response.Results), response.Provider)
+	}
+
+	story := response.Results[0]
+	if story.URL != "https://news.ycombinator.com/item?id=38833143" || story.Title != "Go 1.22 Release Notes" {
+		t.Errorf("Expected the story to link to its discussion page, got %q (%q)", story.URL, story.Title)
+	}
+	if story.Metadata["article_url"] != "https://go.dev/doc/go1.22" {
+		t.Errorf("Expected article_url metadata, got %v", story.Metadata["article_url"])
+	}
+	if story.Metadata["points"] != 412 || story.Metadata["comment_count"] != 187 || story.Metadata["author"] != "gopher" {
+		t.Errorf("Unexpected story metadata: %v", story.Metadata)
+	}
+	if story.Metadata["published"] != "2024-02-06T18:00:00Z" {
+		t.Errorf("Expected RFC3339 published metadata, got %v", story.Metadata["published"])
+	}
+	if story.Description != "412 points by gopher, 187 comments" {
+		t.Errorf("Expected a summary description for a link story, got %q", story.Description)
+	}
+
+	ask := response.Results[1]
+	if ask.Description != "We're growing past 50 packages. What layouts have worked for you?" {
+		t.Errorf("Expected story text as plain text, got %q", ask.Description)
+	}
+	if _, ok := ask.Metadata["article_url"]; ok {
+		t.Errorf("Expected no article_url for a text story, got %v", ask.Metadata["article_url"])
+	}
+
+	comment := response.Results[2]
+	if comment.Title != "Comment on: Go 1.22 Release Notes" || comment.Description != "The range-over-int change is small but welcome." {
+		t.Errorf("Unexpected comment result: %q, %q", comment.Title, comment.Description)
+	}
+	if comment.Metadata["item_type"] != "comment" || comment.Metadata["story_id"] != 38833143 || comment.Metadata["article_url"] != "https://go.dev/doc/go1.22" {
+		t.Errorf("Unexpected comment metadata: %v", comment.Metadata)
+	}
+	if _, ok := comment.Metadata["points"]; ok {
+		t.Errorf("Expected no points for a comment with null points, got %v", comment.Metadata["points"])
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		defaultSort string
+		timeRange   string
+		want        HackerNewsSearchRequest
+		wantErr     bool
+	}{
+		{
+			name:        "web defaults",
+			args:        map[string]any{"query": "golang"},
+			defaultSort: internetsearch.SortRelevance,
+			want:        HackerNewsSearchRequest{Query: "golang", Tags: "story", HitsPerPage: 10},
+		},
+		{
+			name:        "news sorts by date",
+			args:        map[string]any{"query": "golang"},
+			defaultSort: internetsearch.SortDate,
+			want:        HackerNewsSearchRequest{Query: "golang", Tags: "story", HitsPerPage: 10, ByDate: true},
+		},
+		{
+			name:        "explicit sort, type and time range",
+			args:        map[string]any{"query": "golang", "count": float64(25), "hn_item_type": "show_hn", "sort": "relevance"},
+			defaultSort: internetsearch.SortDate,
+			timeRange:   internetsearch.TimeRangeWeek,
+			want:        HackerNewsSearchRequest{Query: "golang", Tags: "show_hn", HitsPerPage: 25, NumericFilters: "created_at_i>1708689600"},
+		},
+		{name: "invalid item type", args: map[string]any{"query": "golang", "hn_item_type": "job"}, defaultSort: internetsearch.SortRelevance, wantErr: true},
+		{name: "invalid sort", args: map[string]any{"query": "golang", "sort": "points"}, defaultSort: internetsearch.SortRelevance, wantErr: true},
+		{name: "count too large", args: map[string]any{"query": "golang", "count": float64(51)}, defaultSort: internetsearch.SortRelevance, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildRequest(tt.args, tt.defaultSort, tt.timeRange, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestHackerNewsProvider_NewsByDate(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		_, _ = w.Write([]byte(`{"hits": [], "nbHits": 0}`))
+	}))
+	defer server.Close()
+
+	response, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{
+		"query":      "golang",
+		"time_range": "day",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.URL.Path != "/search_by_date" {
+		t.Errorf("Expected the date endpoint for news search, got %s", received.URL.Path)
+	}
+	if !strings.HasPrefix(received.URL.Query().Get("numericFilters"), "created_at_i>") {
+		t.Errorf("Expected a created_at_i filter for the time range, got %q", received.URL.Query().Get("numericFilters"))
+	}
+	if len(response.Results) != 0 || response.TimeRange != "day" {
+		t.Errorf("Expected an empty response with the time range applied, got %+v", response)
+	}
+}
+
+func TestHackerNewsProvider_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		check  func(error) bool
+	}{
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			body:   `{"message": "Too many requests", "status": 429}`,
+			check:  func(err error) bool { return errors.Is(err, internetsearch.ErrRateLimited) },
+		},
+		{
+			name:   "api error message",
+			status: http.StatusBadRequest,
+			body:   `{"message": "invalid numericFilters", "status": 400}`,
+			check:  func(err error) bool { return strings.Contains(err.Error(), "invalid numericFilters") },
+		},
+		{
+			name:   "malformed response",
+			status: http.StatusOK,
+			body:   `<html>not json</html>`,
+			check:  func(err error) bool { return errors.Is(err, internetsearch.ErrParse) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+			if err == nil || !tt.check(err) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
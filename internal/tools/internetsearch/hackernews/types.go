@@ -0,0 +1,35 @@
+package hackernews
+
+// HackerNewsSearchResponse represents the response from the Algolia Hacker News search API
+type HackerNewsSearchResponse struct {
+	Hits        []HackerNewsHit `json:"hits"`
+	NbHits      int             `json:"nbHits"`
+	Page        int             `json:"page"`
+	NbPages     int             `json:"nbPages"`
+	HitsPerPage int             `json:"hitsPerPage"`
+}
+
+// HackerNewsHit represents a single story or comment from the Algolia Hacker News search API.
+// Counts are pointers as Algolia returns null for fields that don't apply to the item type.
+type HackerNewsHit struct {
+	ObjectID    string   `json:"objectID"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url"`
+	Author      string   `json:"author"`
+	Points      *int     `json:"points"`
+	NumComments *int     `json:"num_comments"`
+	StoryText   string   `json:"story_text"`
+	CommentText string   `json:"comment_text"`
+	StoryID     *int     `json:"story_id"`
+	StoryTitle  string   `json:"story_title"`
+	StoryURL    string   `json:"story_url"`
+	CreatedAt   string   `json:"created_at"`
+	CreatedAtI  int64    `json:"created_at_i"`
+	Tags        []string `json:"_tags"`
+}
+
+// HackerNewsErrorResponse represents an error response from the Algolia API
+type HackerNewsErrorResponse struct {
+	Message string `json:"message"`
+	Status  int    `json:"status"`
+}
@@ -0,0 +1,102 @@
+package internetsearch
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Host describes the host of a result URL in the forms needed for display, comparison and filtering
+type Host struct {
+	Name        string // Lower-case host in Unicode form, without port or trailing dot
+	ASCII       string // Host in ASCII (punycode) form, used for comparison and in constructed URLs
+	Domain      string // Registered domain (eTLD+1) in Unicode form, or the host itself when there isn't one
+	IP          bool   // Set for IP literal hosts, which have no registered domain
+	asciiDomain string
+}
+
+// ParseHost extracts the host of a URL, reporting false when the URL has none
+func ParseHost(rawURL string) (Host, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return Host{}, false
+	}
+	return hostOf(parsed)
+}
+
+// hostOf extracts the host of a parsed URL. IDN hosts are accepted in either Unicode or punycode form,
+// and hosts with no registered domain (IP literals, "localhost", bare public suffixes) use the host itself.
+func hostOf(parsed *url.URL) (Host, bool) {
+	name := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if name == "" {
+		return Host{}, false
+	}
+	if ip := net.ParseIP(name); ip != nil {
+		name = ip.String()
+		return Host{Name: name, ASCII: name, Domain: name, IP: true, asciiDomain: name}, true
+	}
+
+	ascii, err := idna.Lookup.ToASCII(name)
+	if err != nil {
+		ascii = name
+	}
+	asciiDomain, err := publicsuffix.EffectiveTLDPlusOne(ascii)
+	if err != nil {
+		asciiDomain = ascii
+	}
+	return Host{
+		Name:        toUnicode(ascii),
+		ASCII:       ascii,
+		Domain:      toUnicode(asciiDomain),
+		asciiDomain: asciiDomain,
+	}, true
+}
+
+// toUnicode converts a punycode host to Unicode for display, leaving it unchanged if it can't be converted
+func toUnicode(ascii string) string {
+	if unicode, err := idna.Lookup.ToUnicode(ascii); err == nil {
+		return unicode
+	}
+	return ascii
+}
+
+// FaviconURL returns the conventional favicon location for the host
+func (h Host) FaviconURL() string {
+	host := h.ASCII
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]" // IPv6 literal
+	}
+	return "https://" + host + "/favicon.ico"
+}
+
+// canonical returns the host used in comparison keys: the ASCII form with a "www." label dropped
+// when it's a subdomain of the registered domain, so "www.co.uk" style hosts are left intact
+func (h Host) canonical() string {
+	if trimmed, ok := strings.CutPrefix(h.ASCII, "www."); ok && !h.IP && h.ASCII != h.asciiDomain {
+		return trimmed
+	}
+	return h.ASCII
+}
+
+// AddHostMetadata records each result's "domain", "host" and "favicon_url" metadata. A favicon_url
+// already supplied by the provider is kept, and results without a parseable host are left as-is.
+func AddHostMetadata(results []SearchResult) {
+	for i := range results {
+		host, ok := ParseHost(results[i].URL)
+		if !ok {
+			continue
+		}
+		if results[i].Metadata == nil {
+			results[i].Metadata = make(map[string]any)
+		}
+		metadata := results[i].Metadata
+		metadata["domain"] = host.Domain
+		metadata["host"] = host.Name
+		if favicon, _ := metadata["favicon_url"].(string); favicon == "" {
+			metadata["favicon_url"] = host.FaviconURL()
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package internetsearch
+
+import "testing"
+
+func TestParseHost(t *testing.T) {
+	tests := []struct {
+		url     string
+		name    string
+		ascii   string
+		domain  string
+		favicon string
+		ip      bool
+	}{
+		{url: "https://go.dev/doc", name: "go.dev", ascii: "go.dev", domain: "go.dev", favicon: "https://go.dev/favicon.ico"},
+		{url: "https://Docs.Example.co.uk:8443/guide", name: "docs.example.co.uk", ascii: "docs.example.co.uk", domain: "example.co.uk", favicon: "https://docs.example.co.uk/favicon.ico"},
+		{url: "https://user.github.io./repo", name: "user.github.io", ascii: "user.github.io", domain: "user.github.io", favicon: "https://user.github.io/favicon.ico"},
+		{url: "https://www.münchen.de/", name: "www.münchen.de", ascii: "www.xn--mnchen-3ya.de", domain: "münchen.de", favicon: "https://www.xn--mnchen-3ya.de/favicon.ico"},
+		{url: "https://www.xn--mnchen-3ya.de/", name: "www.münchen.de", ascii: "www.xn--mnchen-3ya.de", domain: "münchen.de", favicon: "https://www.xn--mnchen-3ya.de/favicon.ico"},
+		{url: "http://192.168.1.10:8080/status", name: "192.168.1.10", ascii: "192.168.1.10", domain: "192.168.1.10", favicon: "https://192.168.1.10/favicon.ico", ip: true},
+		{url: "http://[2001:DB8::1]/status", name: "2001:db8::1", ascii: "2001:db8::1", domain: "2001:db8::1", favicon: "https://[2001:db8::1]/favicon.ico", ip: true},
+		{url: "http://localhost:3000/", name: "localhost", ascii: "localhost", domain: "localhost", favicon: "https://localhost/favicon.ico"},
+	}
+
+	for _, tt := range tests {
+		host, ok := ParseHost(tt.url)
+		if !ok {
+			t.Errorf("ParseHost(%q) failed", tt.url)
+			continue
+		}
+		if host.Name != tt.name || host.ASCII != tt.ascii || host.Domain != tt.domain || host.IP != tt.ip {
+			t.Errorf("ParseHost(%q) = %+v, expected name %q, ascii %q, domain %q, ip %v", tt.url, host, tt.name, tt.ascii, tt.domain, tt.ip)
+		}
+		if got := host.FaviconURL(); got != tt.favicon {
+			t.Errorf("FaviconURL() for %q = %q, expected %q", tt.url, got, tt.favicon)
+		}
+	}
+
+	for _, invalid := range []string{"", "not a url", "mailto:someone@example.com", "/relative/path"} {
+		if host, ok := ParseHost(invalid); ok {
+			t.Errorf("Expected ParseHost(%q) to fail, got %+v", invalid, host)
+		}
+	}
+}
+
+func TestAddHostMetadata(t *testing.T) {
+	results := []SearchResult{
+		{URL: "https://pkg.go.dev/fmt"},
+		{URL: "https://go.dev/doc", Metadata: map[string]any{"favicon_url": "https://imgs.example.com/go.png"}},
+		{URL: "not a url"},
+	}
+	AddHostMetadata(results)
+
+	first := results[0].Metadata
+	if first["domain"] != "go.dev" || first["host"] != "pkg.go.dev" || first["favicon_url"] != "https://pkg.go.dev/favicon.ico" {
+		t.Errorf("Unexpected host metadata: %v", first)
+	}
+	if favicon := results[1].Metadata["favicon_url"]; favicon != "https://imgs.example.com/go.png" {
+		t.Errorf("Expected the provider's favicon_url to be kept, got %v", favicon)
+	}
+	if results[2].Metadata != nil {
+		t.Errorf("Expected no metadata for a URL without a host, got %v", results[2].Metadata)
+	}
+}
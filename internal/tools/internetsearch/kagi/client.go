@@ -122,18 +122,18 @@ func (c *KagiClient) makeRequest(ctx context.Context, logger *logrus.Logger, end
 				continue
 			}
 
-			return nil, fmt.Errorf("failed to make request after %d attempts: %w", maxRetries, err)
+			return nil, fmt.Errorf("%w: failed to make request after %d attempts: %w", internetsearch.ErrNetwork, maxRetries, err)
 		}
 
 		// Process successful response with security analysis
-		return c.processResponseWithSecurity(logger, resp, reqURL.String())
+		return c.processResponseWithSecurity(ctx, logger, resp, reqURL.String())
 	}
 
 	return nil, fmt.Errorf("unexpected end of retry loop")
 }
 
 // processResponseWithSecurity handles the HTTP response processing with security analysis
-func (c *KagiClient) processResponseWithSecurity(logger *logrus.Logger, resp *http.Response, requestURL string) ([]byte, error) {
+func (c *KagiClient) processResponseWithSecurity(ctx context.Context, logger *logrus.Logger, resp *http.Response, requestURL string) ([]byte, error) {
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
 			logger.WithError(closeErr).Warn("Failed to close response body")
@@ -160,6 +160,7 @@ func (c *KagiClient) processResponseWithSecurity(logger *logrus.Logger, resp *ht
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
+	internetsearch.CaptureResponse(ctx, logger, "kagi", resp, body)
 
 	// Security analysis on content
 	if security.IsEnabled() {
@@ -199,11 +200,14 @@ func (c *KagiClient) processResponseWithSecurity(logger *logrus.Logger, resp *ht
 		// Provide specific error messages for common status codes
 		switch resp.StatusCode {
 		case http.StatusUnauthorized:
-			return nil, fmt.Errorf("authentication failed: invalid API key")
+			return nil, fmt.Errorf("%w: invalid API key", internetsearch.ErrAuth)
 		case http.StatusForbidden:
-			return nil, fmt.Errorf("access forbidden: check your API key and subscription plan")
+			return nil, internetsearch.Classify(internetsearch.ErrAuth, "access forbidden: check your API key and subscription plan")
 		case http.StatusTooManyRequests:
-			return nil, fmt.Errorf("rate limit exceeded: please wait before making more requests")
+			return nil, &internetsearch.RateLimitError{
+				Provider:   "kagi",
+				RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			}
 		case http.StatusInternalServerError:
 			return nil, fmt.Errorf("kagi API internal server error: please try again later")
 		default:
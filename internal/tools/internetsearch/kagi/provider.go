@@ -44,6 +44,14 @@ func (p *KagiProvider) GetSupportedTypes() []string {
 	return []string{"web"}
 }
 
+// Describe returns how the provider is configured, for provider listings
+func (p *KagiProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "Kagi Search API with web search",
+		RequiredEnvVars: []string{"KAGI_API_KEY"},
+	}
+}
+
 // Search executes a search using the Kagi provider
 func (p *KagiProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
@@ -54,12 +62,25 @@ func (p *KagiProvider) Search(ctx context.Context, logger *logrus.Logger, search
 		"query":    query,
 	}).Debug("Kagi search parameters")
 
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *internetsearch.SearchResponse
 	switch searchType {
 	case "web":
-		return p.executeWebSearch(ctx, logger, args)
+		response, err = p.executeWebSearch(ctx, logger, args)
 	default:
 		return nil, fmt.Errorf("unsupported search type for Kagi: %s", searchType)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The Kagi search API has no time range filter
+	response.ApplyTimeRange(timeRange, false)
+	return response, nil
 }
 
 // executeWebSearch handles web search for search results
@@ -3,6 +3,8 @@ package kagi
 import (
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -129,3 +131,31 @@ func TestDecodeHTMLEntities(t *testing.T) {
 		}
 	}
 }
+
+func TestKagiProvider_TimeRangeUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta": {"id": "1"}, "data": [{"t": 0, "url": "https://go.dev/", "title": "Go", "snippet": "The Go language"}]}`))
+	}))
+	defer server.Close()
+
+	provider := &KagiProvider{
+		client: &KagiClient{apiKey: "test-key", baseURL: server.URL, httpClient: server.Client()},
+	}
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	response, err := provider.Search(context.Background(), logger, "web", map[string]any{
+		"query":      "golang",
+		"time_range": "week",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if response.TimeRange != "" {
+		t.Errorf("Expected time range not to be echoed as applied, got %q", response.TimeRange)
+	}
+	if _, ok := response.Metadata["time_range_unsupported"]; !ok {
+		t.Errorf("Expected time_range_unsupported metadata, got %v", response.Metadata)
+	}
+}
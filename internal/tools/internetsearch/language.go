@@ -0,0 +1,331 @@
+package internetsearch
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// MinLanguageConfidence is the confidence a result needs in the requested result_language to be kept
+	MinLanguageConfidence = 0.5
+
+	// minLanguageLetters is the fewest letters detection is attempted on, as shorter text is too ambiguous
+	minLanguageLetters = 8
+
+	// trigramSmoothing is added to every trigram count, so a trigram missing from a sample passage
+	// lowers a language's score rather than ruling it out
+	trigramSmoothing = 0.5
+)
+
+// DetectedLanguage is the likely language of a piece of text
+type DetectedLanguage struct {
+	Code       string  // ISO 639-1 code
+	Confidence float64 // From 0 to 1
+}
+
+// Languages identified by their script rather than by trigrams, as each script is used by one of the
+// supported languages. Cyrillic and Arabic text is split by letters only one of the languages uses.
+var scriptLanguages = []string{"ar", "el", "fa", "he", "hi", "ja", "ko", "ru", "th", "uk", "zh"}
+
+var (
+	// ukrainianLetters and persianLetters tell Ukrainian from Russian, and Persian from Arabic
+	ukrainianLetters = "ієїґ"
+	persianLetters   = "پچژگ"
+)
+
+// SupportedLanguages returns the sorted ISO 639-1 codes DetectLanguage can report
+func SupportedLanguages() []string {
+	codes := append(slices.Collect(maps.Keys(languageSamples)), scriptLanguages...)
+	slices.Sort(codes)
+	return codes
+}
+
+// trigramModel holds the smoothed log probability of each trigram in every Latin-script language,
+// built once from languageSamples
+type trigramModel struct {
+	codes    []string
+	logProbs map[[3]rune][]float64
+	unseen   []float64 // Log probability of a trigram missing from a language's sample
+}
+
+var (
+	latinModel     *trigramModel
+	latinModelOnce sync.Once
+)
+
+func getLatinModel() *trigramModel {
+	latinModelOnce.Do(func() {
+		latinModel = buildTrigramModel(languageSamples)
+	})
+	return latinModel
+}
+
+// buildTrigramModel counts the trigrams of each sample passage and converts them to log probabilities
+func buildTrigramModel(samples map[string]string) *trigramModel {
+	model := &trigramModel{
+		codes:    slices.Sorted(maps.Keys(samples)),
+		logProbs: make(map[[3]rune][]float64),
+	}
+
+	counts := make([]map[[3]rune]float64, len(model.codes))
+	totals := make([]float64, len(model.codes))
+	vocabulary := make(map[[3]rune]struct{})
+	for i, code := range model.codes {
+		counts[i] = make(map[[3]rune]float64)
+		letters, _ := normaliseLetters(samples[code])
+		eachTrigram(letters, func(trigram [3]rune) {
+			counts[i][trigram]++
+			totals[i]++
+			vocabulary[trigram] = struct{}{}
+		})
+	}
+
+	// One extra slot stands for every trigram none of the samples contain
+	size := float64(len(vocabulary) + 1)
+	model.unseen = make([]float64, len(model.codes))
+	for i := range model.codes {
+		model.unseen[i] = math.Log(trigramSmoothing / (totals[i] + trigramSmoothing*size))
+	}
+	for trigram := range vocabulary {
+		logProbs := make([]float64, len(model.codes))
+		for i := range model.codes {
+			logProbs[i] = math.Log((counts[i][trigram] + trigramSmoothing) / (totals[i] + trigramSmoothing*size))
+		}
+		model.logProbs[trigram] = logProbs
+	}
+	return model
+}
+
+// classify returns the most likely language of normalised letters and its confidence. The log likelihoods
+// are divided by the square root of the trigram count before being compared, as naive Bayes treats
+// overlapping trigrams as independent and would otherwise be near certain about a short title.
+func (m *trigramModel) classify(letters []rune) (string, float64) {
+	scores := make([]float64, len(m.codes))
+	trigrams := 0
+	eachTrigram(letters, func(trigram [3]rune) {
+		trigrams++
+		logProbs, ok := m.logProbs[trigram]
+		if !ok {
+			logProbs = m.unseen
+		}
+		for i := range scores {
+			scores[i] += logProbs[i]
+		}
+	})
+	if trigrams == 0 {
+		return "", 0
+	}
+	scale := math.Sqrt(float64(trigrams))
+	for i := range scores {
+		scores[i] /= scale
+	}
+
+	best := 0
+	for i := range scores {
+		if scores[i] > scores[best] {
+			best = i
+		}
+	}
+	var total float64
+	for i := range scores {
+		total += math.Exp(scores[i] - scores[best])
+	}
+	return m.codes[best], 1 / total
+}
+
+// DetectLanguage guesses the language of text from its script and, for Latin-script text, its letter
+// trigrams. It reports false when the text has too few letters to judge.
+func DetectLanguage(text string) (DetectedLanguage, bool) {
+	letters, scripts := normaliseLetters(text)
+	var letterCount int
+	for _, count := range scripts {
+		letterCount += count
+	}
+	if letterCount < minLanguageLetters {
+		return DetectedLanguage{}, false
+	}
+
+	// Japanese mixes kana with Han characters, whereas Chinese uses Han alone
+	if scripts[scriptKana] > 0 && scripts[scriptKana]+scripts[scriptHan] > letterCount/2 {
+		return detected("ja", scripts[scriptKana]+scripts[scriptHan], letterCount), true
+	}
+
+	dominant := scriptLatin
+	for script, count := range scripts {
+		if count > scripts[dominant] {
+			dominant = script
+		}
+	}
+	share := scripts[dominant]
+
+	switch dominant {
+	case scriptLatin:
+		code, probability := getLatinModel().classify(letters)
+		return DetectedLanguage{Code: code, Confidence: roundConfidence(probability * float64(share) / float64(letterCount))}, true
+	case scriptCyrillic:
+		if strings.ContainsAny(string(letters), ukrainianLetters) {
+			return detected("uk", share, letterCount), true
+		}
+		return detected("ru", share, letterCount), true
+	case scriptArabic:
+		if strings.ContainsAny(string(letters), persianLetters) {
+			return detected("fa", share, letterCount), true
+		}
+		return detected("ar", share, letterCount), true
+	default:
+		return detected(scriptCodes[dominant], share, letterCount), true
+	}
+}
+
+// detected reports a script-identified language, as confident as the share of letters in its script
+func detected(code string, share, letterCount int) DetectedLanguage {
+	return DetectedLanguage{Code: code, Confidence: roundConfidence(float64(share) / float64(letterCount))}
+}
+
+func roundConfidence(confidence float64) float64 {
+	return math.Round(confidence*100) / 100
+}
+
+// Scripts counted by normaliseLetters
+const (
+	scriptLatin = iota
+	scriptCyrillic
+	scriptGreek
+	scriptArabic
+	scriptHebrew
+	scriptDevanagari
+	scriptThai
+	scriptHangul
+	scriptKana
+	scriptHan
+	scriptCount
+)
+
+// scriptCodes maps the scripts used by a single supported language to its code
+var scriptCodes = [scriptCount]string{
+	scriptGreek:      "el",
+	scriptHebrew:     "he",
+	scriptDevanagari: "hi",
+	scriptThai:       "th",
+	scriptHangul:     "ko",
+	scriptHan:        "zh",
+}
+
+var scriptTables = [scriptCount]*unicode.RangeTable{
+	scriptLatin:      unicode.Latin,
+	scriptCyrillic:   unicode.Cyrillic,
+	scriptGreek:      unicode.Greek,
+	scriptArabic:     unicode.Arabic,
+	scriptHebrew:     unicode.Hebrew,
+	scriptDevanagari: unicode.Devanagari,
+	scriptThai:       unicode.Thai,
+	scriptHangul:     unicode.Hangul,
+	scriptHan:        unicode.Han,
+}
+
+// normaliseLetters lower-cases text and reduces everything between words to a single space, with a space
+// at each end so every word contributes its opening and closing trigrams. It also counts the letters of
+// each script.
+func normaliseLetters(text string) ([]rune, [scriptCount]int) {
+	var scripts [scriptCount]int
+	letters := make([]rune, 1, len(text)+2)
+	letters[0] = ' '
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			if letters[len(letters)-1] != ' ' {
+				letters = append(letters, ' ')
+			}
+			continue
+		}
+
+		letters = append(letters, unicode.ToLower(r))
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana) {
+			scripts[scriptKana]++
+			continue
+		}
+		for script, table := range scriptTables {
+			if table != nil && unicode.Is(table, r) {
+				scripts[script]++
+				break
+			}
+		}
+	}
+
+	if letters[len(letters)-1] != ' ' {
+		letters = append(letters, ' ')
+	}
+	return letters, scripts
+}
+
+// eachTrigram calls fn for every run of three characters in normalised letters that isn't just spaces
+func eachTrigram(letters []rune, fn func([3]rune)) {
+	for i := 0; i+3 <= len(letters); i++ {
+		trigram := [3]rune{letters[i], letters[i+1], letters[i+2]}
+		if trigram[1] == ' ' {
+			continue // Spans a single-letter word, e.g. " a "
+		}
+		fn(trigram)
+	}
+}
+
+// AddLanguageMetadata records each result's "detected_language" as its code and confidence, judged from
+// the title and description. Results with too little text are left without one. It's kept apart from the
+// "language" some providers set, such as a GitHub repository's programming language.
+func AddLanguageMetadata(results []SearchResult) {
+	for i := range results {
+		text := strings.TrimSpace(results[i].Title + " " + results[i].Description)
+		if text == "" {
+			continue
+		}
+		language, ok := DetectLanguage(text)
+		if !ok {
+			continue
+		}
+		if results[i].Metadata == nil {
+			results[i].Metadata = make(map[string]any)
+		}
+		results[i].Metadata["detected_language"] = map[string]any{
+			"code":       language.Code,
+			"confidence": language.Confidence,
+		}
+	}
+}
+
+// ParseResultLanguage reads and validates the optional result_language argument, returning "" when unset
+func ParseResultLanguage(args map[string]any) (string, error) {
+	raw, ok := args["result_language"].(string)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+
+	code := strings.ToLower(strings.TrimSpace(raw))
+	supported := SupportedLanguages()
+	if !slices.Contains(supported, code) {
+		return "", fmt.Errorf("invalid result_language %q, must be an ISO 639-1 code, one of: %s", raw, strings.Join(supported, ", "))
+	}
+	return code, nil
+}
+
+// FilterLanguage removes results detected as another language, or as the requested language with less than
+// MinLanguageConfidence. Results without a detected language are kept, as there was too little text to judge.
+// It returns the remaining results and how many were removed.
+func FilterLanguage(results []SearchResult, code string) ([]SearchResult, int) {
+	kept := results[:0]
+	for _, result := range results {
+		language, ok := result.Metadata["detected_language"].(map[string]any)
+		if ok {
+			confidence, _ := language["confidence"].(float64)
+			if language["code"] != code || confidence < MinLanguageConfidence {
+				continue
+			}
+		}
+		kept = append(kept, result)
+	}
+	return kept, len(results) - len(kept)
+}
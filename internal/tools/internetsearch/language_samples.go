@@ -0,0 +1,171 @@
+package internetsearch
+
+// languageSamples holds a short passage of everyday prose per Latin-script language, from which the
+// trigram profiles used by DetectLanguage are built. Each passage leans on the common function words
+// that dominate search snippets, so adding a language only needs a passage of similar length.
+var languageSamples = map[string]string{
+	"en": `The quick brown fox jumps over the lazy dog. This guide explains how to install the package and
+		configure it for your project. If you have any questions about the release, please read the
+		documentation first and then open an issue on the tracker. We are working on a new version that
+		will be faster and easier to use than the old one. Most of the changes were made by people in the
+		community who wanted to share what they had learned. There is also a short video which shows the
+		main features and what you should do when something goes wrong. The weather was cold in the
+		morning, but by the afternoon the sun had come out and everyone went outside to enjoy it. You can
+		find more information about these topics in the following articles, which are updated every week.
+		It is important to keep your software up to date, because older versions may not receive any
+		security fixes. Our team would like to thank all of the contributors for their help with this work.
+		How to build a web server with the standard library, step by step for beginners. Learn how to
+		create an API, write tests and deploy your application to the cloud. This article compares the most
+		popular frameworks and shows which one is the best choice for small teams. Read the latest news
+		and reviews, watch the tutorial and download the free example code from our website.`,
+
+	"de": `Der schnelle braune Fuchs springt über den faulen Hund. Diese Anleitung erklärt, wie man das Paket
+		installiert und für das eigene Projekt einrichtet. Wenn Sie Fragen zur neuen Version haben, lesen Sie
+		bitte zuerst die Dokumentation und erstellen Sie dann ein Ticket. Wir arbeiten an einer neuen Ausgabe,
+		die schneller und einfacher zu bedienen ist als die alte. Die meisten Änderungen wurden von Menschen
+		aus der Gemeinschaft gemacht, die ihr Wissen teilen wollten. Es gibt auch ein kurzes Video, das die
+		wichtigsten Funktionen zeigt und erklärt, was zu tun ist, wenn etwas nicht funktioniert. Am Morgen war
+		das Wetter kalt, aber am Nachmittag kam die Sonne heraus und alle gingen nach draußen. Weitere
+		Informationen zu diesen Themen finden Sie in den folgenden Artikeln, die jede Woche aktualisiert
+		werden. Es ist wichtig, die Software aktuell zu halten, weil ältere Versionen keine
+		Sicherheitsupdates mehr bekommen. Unser Team möchte sich bei allen für ihre Hilfe bedanken.
+		So baut man einen Webserver mit der Standardbibliothek, Schritt für Schritt für Anfänger. Lernen Sie,
+		wie man eine Schnittstelle erstellt, Tests schreibt und die Anwendung in der Cloud bereitstellt. Dieser
+		Artikel vergleicht die beliebtesten Frameworks und zeigt, welches für kleine Teams am besten geeignet
+		ist. Lesen Sie die neuesten Nachrichten und Bewertungen und laden Sie den kostenlosen Beispielcode herunter.`,
+
+	"fr": `Le renard brun rapide saute par-dessus le chien paresseux. Ce guide explique comment installer le
+		paquet et le configurer pour votre projet. Si vous avez des questions sur la nouvelle version, veuillez
+		d'abord lire la documentation puis ouvrir un ticket. Nous travaillons sur une nouvelle version qui sera
+		plus rapide et plus facile à utiliser que l'ancienne. La plupart des changements ont été faits par des
+		personnes de la communauté qui voulaient partager ce qu'elles avaient appris. Il existe aussi une
+		courte vidéo qui montre les principales fonctions et ce qu'il faut faire quand quelque chose ne marche
+		pas. Le temps était froid le matin, mais l'après-midi le soleil est sorti et tout le monde est allé
+		dehors. Vous trouverez plus d'informations sur ces sujets dans les articles suivants, qui sont mis à
+		jour chaque semaine. Il est important de garder votre logiciel à jour, car les anciennes versions ne
+		reçoivent plus de correctifs de sécurité. Notre équipe tient à remercier tous les contributeurs.
+		Comment créer un serveur web avec la bibliothèque standard, étape par étape pour les débutants.
+		Apprenez à créer une interface, à écrire des tests et à déployer votre application dans le nuage. Cet
+		article compare les cadres les plus populaires et montre lequel est le meilleur choix pour les petites
+		équipes. Lisez les dernières nouvelles et les avis, regardez le tutoriel et téléchargez le code gratuit.`,
+
+	"es": `El rápido zorro marrón salta sobre el perro perezoso. Esta guía explica cómo instalar el paquete y
+		configurarlo para tu proyecto. Si tienes preguntas sobre la nueva versión, por favor lee primero la
+		documentación y después abre una incidencia. Estamos trabajando en una nueva versión que será más
+		rápida y más fácil de usar que la anterior. La mayoría de los cambios fueron hechos por personas de la
+		comunidad que querían compartir lo que habían aprendido. También hay un vídeo corto que muestra las
+		funciones principales y lo que debes hacer cuando algo no funciona. El tiempo estaba frío por la
+		mañana, pero por la tarde salió el sol y todos salieron a la calle para disfrutarlo. Puedes encontrar
+		más información sobre estos temas en los siguientes artículos, que se actualizan cada semana. Es
+		importante mantener el software actualizado, porque las versiones antiguas no reciben correcciones de
+		seguridad. Nuestro equipo quiere dar las gracias a todas las personas que han ayudado con este trabajo.
+		Cómo crear un servidor web con la biblioteca estándar, paso a paso para principiantes. Aprende a
+		crear una interfaz, escribir pruebas y desplegar tu aplicación en la nube. Este artículo compara los
+		marcos más populares y muestra cuál es la mejor opción para equipos pequeños. Lee las últimas noticias
+		y opiniones, mira el tutorial y descarga el código de ejemplo gratuito desde nuestro sitio.`,
+
+	"pt": `A rápida raposa marrom salta sobre o cão preguiçoso. Este guia explica como instalar o pacote e
+		configurá-lo para o seu projeto. Se você tiver dúvidas sobre a nova versão, leia primeiro a
+		documentação e depois abra um chamado. Estamos trabalhando em uma nova versão que será mais rápida e
+		mais fácil de usar do que a antiga. A maioria das mudanças foi feita por pessoas da comunidade que
+		queriam partilhar o que tinham aprendido. Também existe um vídeo curto que mostra as principais
+		funções e o que você deve fazer quando algo não funciona. O tempo estava frio de manhã, mas à tarde o
+		sol apareceu e todos foram para a rua aproveitar. Você pode encontrar mais informações sobre esses
+		assuntos nos seguintes artigos, que são atualizados todas as semanas. É importante manter o software
+		atualizado, porque as versões antigas não recebem correções de segurança. A nossa equipe agradece a
+		todas as pessoas que ajudaram neste trabalho e que continuam a contribuir com o projeto.
+		Como criar um servidor web com a biblioteca padrão, passo a passo para iniciantes. Aprenda a criar
+		uma interface, escrever testes e implantar a sua aplicação na nuvem. Este artigo compara as
+		ferramentas mais populares e mostra qual é a melhor escolha para equipes pequenas. Leia as últimas
+		notícias e análises, assista ao tutorial e baixe o código de exemplo gratuito do nosso site.`,
+
+	"it": `La veloce volpe marrone salta sopra il cane pigro. Questa guida spiega come installare il pacchetto
+		e configurarlo per il tuo progetto. Se hai domande sulla nuova versione, per favore leggi prima la
+		documentazione e poi apri una segnalazione. Stiamo lavorando a una nuova versione che sarà più veloce e
+		più facile da usare di quella vecchia. La maggior parte delle modifiche è stata fatta da persone della
+		comunità che volevano condividere quello che avevano imparato. C'è anche un breve video che mostra le
+		funzioni principali e che cosa fare quando qualcosa non funziona. Il tempo era freddo la mattina, ma
+		nel pomeriggio è uscito il sole e tutti sono andati fuori a godersi la giornata. Puoi trovare altre
+		informazioni su questi argomenti negli articoli seguenti, che vengono aggiornati ogni settimana. È
+		importante mantenere il software aggiornato, perché le versioni più vecchie non ricevono correzioni di
+		sicurezza. Il nostro gruppo vuole ringraziare tutte le persone che hanno aiutato con questo lavoro.
+		Come creare un server web con la libreria standard, passo dopo passo per principianti. Impara a
+		creare un'interfaccia, scrivere i test e pubblicare la tua applicazione nel cloud. Questo articolo
+		confronta gli strumenti più diffusi e mostra quale sia la scelta migliore per i gruppi piccoli. Leggi
+		le ultime notizie e recensioni, guarda la guida e scarica gratuitamente il codice di esempio dal sito.`,
+
+	"nl": `De snelle bruine vos springt over de luie hond. Deze handleiding legt uit hoe je het pakket
+		installeert en voor je eigen project instelt. Als je vragen hebt over de nieuwe versie, lees dan eerst
+		de documentatie en maak daarna een melding aan. We werken aan een nieuwe versie die sneller en
+		makkelijker te gebruiken is dan de oude. De meeste wijzigingen zijn gemaakt door mensen uit de
+		gemeenschap die wilden delen wat ze hadden geleerd. Er is ook een korte video die de belangrijkste
+		functies laat zien en uitlegt wat je moet doen als er iets misgaat. Het weer was koud in de ochtend,
+		maar in de middag kwam de zon door en ging iedereen naar buiten om ervan te genieten. Meer informatie
+		over deze onderwerpen vind je in de volgende artikelen, die elke week worden bijgewerkt. Het is
+		belangrijk om je software bij te houden, omdat oudere versies geen beveiligingsupdates meer krijgen.
+		Ons team wil alle bijdragers bedanken voor hun hulp bij dit werk en voor hun geduld.
+		Zo bouw je een webserver met de standaardbibliotheek, stap voor stap voor beginners. Leer hoe je een
+		koppeling maakt, tests schrijft en je toepassing in de cloud uitrolt. Dit artikel vergelijkt de meest
+		gebruikte raamwerken en laat zien welke het beste past bij kleine teams. Lees het laatste nieuws en
+		de recensies, bekijk de uitleg en download de gratis voorbeeldcode van onze website.`,
+
+	"sv": `Den snabba bruna räven hoppar över den lata hunden. Den här guiden förklarar hur du installerar
+		paketet och ställer in det för ditt projekt. Om du har frågor om den nya versionen, läs först
+		dokumentationen och skapa sedan ett ärende. Vi arbetar på en ny version som kommer att vara snabbare
+		och enklare att använda än den gamla. De flesta ändringarna gjordes av personer i gemenskapen som
+		ville dela med sig av det de hade lärt sig. Det finns också en kort video som visar de viktigaste
+		funktionerna och vad du ska göra när något inte fungerar. Vädret var kallt på morgonen, men på
+		eftermiddagen kom solen fram och alla gick ut för att njuta av den. Du hittar mer information om
+		dessa ämnen i följande artiklar, som uppdateras varje vecka. Det är viktigt att hålla programvaran
+		uppdaterad, eftersom äldre versioner inte längre får några säkerhetsrättningar. Vårt team vill tacka
+		alla som har hjälpt till med det här arbetet och som fortsätter att bidra till projektet.
+		Så bygger du en webbserver med standardbiblioteket, steg för steg för nybörjare. Lär dig hur du
+		skapar ett gränssnitt, skriver tester och driftsätter ditt program i molnet. Den här artikeln jämför
+		de mest populära ramverken och visar vilket som är det bästa valet för små team. Läs de senaste
+		nyheterna och recensionerna, titta på guiden och ladda ner den kostnadsfria exempelkoden.`,
+
+	"pl": `Szybki brązowy lis przeskakuje nad leniwym psem. Ten przewodnik wyjaśnia, jak zainstalować pakiet
+		i skonfigurować go dla swojego projektu. Jeśli masz pytania dotyczące nowej wersji, najpierw przeczytaj
+		dokumentację, a potem zgłoś problem. Pracujemy nad nową wersją, która będzie szybsza i łatwiejsza w
+		użyciu niż poprzednia. Większość zmian została wprowadzona przez osoby ze społeczności, które chciały
+		podzielić się tym, czego się nauczyły. Jest też krótki film, który pokazuje najważniejsze funkcje i
+		wyjaśnia, co zrobić, gdy coś nie działa. Rano pogoda była zimna, ale po południu wyszło słońce i
+		wszyscy wyszli na zewnątrz, żeby się nim cieszyć. Więcej informacji na te tematy znajdziesz w
+		następujących artykułach, które są aktualizowane co tydzień. Ważne jest, aby oprogramowanie było
+		zawsze aktualne, ponieważ starsze wersje nie otrzymują już poprawek bezpieczeństwa. Nasz zespół
+		chciałby podziękować wszystkim, którzy pomogli przy tej pracy i nadal wspierają projekt.
+		Jak zbudować serwer internetowy z biblioteką standardową, krok po kroku dla początkujących. Dowiedz
+		się, jak utworzyć interfejs, pisać testy i wdrożyć aplikację w chmurze. Ten artykuł porównuje
+		najpopularniejsze narzędzia i pokazuje, które jest najlepszym wyborem dla małych zespołów. Przeczytaj
+		najnowsze wiadomości i recenzje, obejrzyj poradnik i pobierz darmowy przykładowy kod z naszej strony.`,
+
+	"tr": `Hızlı kahverengi tilki tembel köpeğin üzerinden atlar. Bu kılavuz paketin nasıl kurulacağını ve
+		projeniz için nasıl yapılandırılacağını açıklıyor. Yeni sürüm hakkında sorularınız varsa lütfen önce
+		belgeleri okuyun ve ardından bir kayıt açın. Eskisinden daha hızlı ve kullanımı daha kolay olacak yeni
+		bir sürüm üzerinde çalışıyoruz. Değişikliklerin çoğu, öğrendiklerini paylaşmak isteyen topluluk
+		üyeleri tarafından yapıldı. Ayrıca temel özellikleri gösteren ve bir şey çalışmadığında ne yapmanız
+		gerektiğini anlatan kısa bir video da var. Sabah hava soğuktu, ama öğleden sonra güneş çıktı ve herkes
+		bunun tadını çıkarmak için dışarı çıktı. Bu konular hakkında daha fazla bilgiyi her hafta güncellenen
+		aşağıdaki makalelerde bulabilirsiniz. Yazılımınızı güncel tutmanız önemlidir, çünkü eski sürümler
+		artık güvenlik düzeltmeleri almıyor. Ekibimiz bu çalışmaya yardım eden herkese teşekkür etmek istiyor.
+		Standart kütüphane ile adım adım bir web sunucusu nasıl kurulur, yeni başlayanlar için anlatıyoruz.
+		Bir arayüz oluşturmayı, testler yazmayı ve uygulamanızı buluta yüklemeyi öğrenin. Bu makale en
+		popüler araçları karşılaştırıyor ve küçük ekipler için hangisinin en iyi seçim olduğunu gösteriyor.
+		Son haberleri ve incelemeleri okuyun, eğitimi izleyin ve ücretsiz örnek kodu sitemizden indirin.`,
+
+	"id": `Rubah cokelat yang cepat melompati anjing yang malas. Panduan ini menjelaskan cara memasang paket
+		dan mengaturnya untuk proyek Anda. Jika Anda memiliki pertanyaan tentang versi baru, silakan baca
+		dokumentasi terlebih dahulu lalu buat laporan masalah. Kami sedang mengerjakan versi baru yang akan
+		lebih cepat dan lebih mudah digunakan daripada versi lama. Sebagian besar perubahan dibuat oleh
+		orang-orang dari komunitas yang ingin berbagi apa yang telah mereka pelajari. Ada juga video pendek
+		yang menunjukkan fitur utama dan apa yang harus Anda lakukan ketika sesuatu tidak berjalan dengan
+		baik. Cuaca pada pagi hari terasa dingin, tetapi pada sore hari matahari bersinar dan semua orang
+		keluar untuk menikmatinya. Anda dapat menemukan informasi lebih lanjut tentang topik ini dalam
+		artikel berikut, yang diperbarui setiap minggu. Penting untuk selalu memperbarui perangkat lunak
+		Anda, karena versi yang lebih lama tidak lagi menerima perbaikan keamanan. Tim kami ingin berterima
+		kasih kepada semua orang yang telah membantu pekerjaan ini.
+		Cara membuat server web dengan pustaka standar, langkah demi langkah untuk pemula. Pelajari cara
+		membuat antarmuka, menulis pengujian, dan memasang aplikasi Anda di awan. Artikel ini membandingkan
+		kerangka kerja yang paling populer dan menunjukkan mana yang terbaik untuk tim kecil. Baca berita dan
+		ulasan terbaru, tonton tutorialnya, dan unduh contoh kode gratis dari situs kami.`,
+}
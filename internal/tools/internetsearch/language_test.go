@@ -0,0 +1,149 @@
+package internetsearch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "Getting started with generics in Go. This tutorial introduces the basics of type parameters and how to use them.", want: "en"},
+		{text: "Die Programmiersprache Go wurde bei Google entwickelt und ist für ihre schnelle Übersetzung bekannt", want: "de"},
+		{text: "Les nouveautés de la dernière version du langage et ce qu'elles changent pour les développeurs", want: "fr"},
+		{text: "Las novedades de la última versión del lenguaje y lo que significan para los desarrolladores", want: "es"},
+		{text: "As novidades da última versão da linguagem e o que elas significam para os desenvolvedores", want: "pt"},
+		{text: "Le novità dell'ultima versione del linguaggio e cosa significano per gli sviluppatori", want: "it"},
+		{text: "De nieuwe functies van de laatste versie van de taal en wat ze betekenen voor ontwikkelaars", want: "nl"},
+		{text: "Nyheterna i den senaste versionen av språket och vad de betyder för utvecklare", want: "sv"},
+		{text: "Nowości w najnowszej wersji języka i co one oznaczają dla programistów", want: "pl"},
+		{text: "Dilin son sürümündeki yenilikler ve bunların geliştiriciler için anlamı", want: "tr"},
+		{text: "Fitur baru dalam versi terbaru bahasa ini dan apa artinya bagi para pengembang", want: "id"},
+		{text: "Новые возможности последней версии языка и что они значат для разработчиков", want: "ru"},
+		{text: "Нові можливості останньої версії мови та що вони означають для розробників", want: "uk"},
+		{text: "Τα νέα χαρακτηριστικά της τελευταίας έκδοσης της γλώσσας", want: "el"},
+		{text: "الميزات الجديدة في أحدث إصدار من اللغة", want: "ar"},
+		{text: "ویژگی‌های جدید در آخرین نسخه زبان برنامه‌نویسی چیست", want: "fa"},
+		{text: "התכונות החדשות בגרסה האחרונה של השפה", want: "he"},
+		{text: "भाषा के नवीनतम संस्करण में नई सुविधाएँ", want: "hi"},
+		{text: "คุณสมบัติใหม่ในภาษาเวอร์ชันล่าสุด", want: "th"},
+		{text: "언어의 최신 버전에 추가된 새로운 기능", want: "ko"},
+		{text: "言語の最新バージョンで追加された新機能について", want: "ja"},
+		{text: "该语言最新版本中的新功能介绍与使用说明", want: "zh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, ok := DetectLanguage(tt.text)
+			if !ok {
+				t.Fatalf("Expected a language for %q", tt.text)
+			}
+			if got.Code != tt.want || got.Confidence < MinLanguageConfidence || got.Confidence > 1 {
+				t.Errorf("Expected %s with confidence of at least %.1f, got %+v", tt.want, MinLanguageConfidence, got)
+			}
+		})
+	}
+}
+
+func TestDetectLanguage_TooLittleText(t *testing.T) {
+	for _, text := range []string{"", "   ", "Go", "v1.2.3 (2024-01-02)", "🚀 🚀 🚀"} {
+		if got, ok := DetectLanguage(text); ok {
+			t.Errorf("Expected no language for %q, got %+v", text, got)
+		}
+	}
+
+	// Product names alone aren't enough to be confident about
+	if got, _ := DetectLanguage("kubernetes"); got.Confidence >= MinLanguageConfidence {
+		t.Errorf("Expected low confidence for a single name, got %+v", got)
+	}
+}
+
+func TestAddLanguageMetadata(t *testing.T) {
+	results := []SearchResult{
+		{Title: "Effective Go", Description: "Tips for writing clear, idiomatic code and avoiding the most common mistakes"},
+		{Title: "", Description: ""},
+		{Title: "Go"},
+	}
+	AddLanguageMetadata(results)
+
+	language, ok := results[0].Metadata["detected_language"].(map[string]any)
+	if !ok || language["code"] != "en" {
+		t.Fatalf("Expected English language metadata, got %v", results[0].Metadata)
+	}
+	if confidence, _ := language["confidence"].(float64); confidence < MinLanguageConfidence {
+		t.Errorf("Expected a confident detection, got %v", confidence)
+	}
+	for _, result := range results[1:] {
+		if _, ok := result.Metadata["detected_language"]; ok {
+			t.Errorf("Expected detection to be skipped for %q, got %v", result.Title, result.Metadata)
+		}
+	}
+}
+
+func TestAddLanguageMetadata_KeepsProviderLanguage(t *testing.T) {
+	results := []SearchResult{
+		// GitHub reports a repository's programming language
+		{Title: "golang/go", Description: "The Go programming language, an open source project to make programmers more productive", Metadata: map[string]any{"language": "Go"}},
+		// Brave reports the page language
+		{Title: "Die Programmiersprache Go", Description: "Eine Einführung in die Sprache und ihre Werkzeuge", Metadata: map[string]any{"language": "de"}},
+	}
+	AddLanguageMetadata(results)
+
+	if results[0].Metadata["language"] != "Go" || results[1].Metadata["language"] != "de" {
+		t.Errorf("Expected the provider languages to be kept, got %v and %v", results[0].Metadata, results[1].Metadata)
+	}
+	detected, _ := results[1].Metadata["detected_language"].(map[string]any)
+	if detected["code"] != "de" {
+		t.Errorf("Expected German to be detected alongside the provider language, got %v", results[1].Metadata)
+	}
+
+	// Filtering uses the detected language, not the provider's
+	kept, removed := FilterLanguage(results, "en")
+	if removed != 1 || len(kept) != 1 || kept[0].Title != "golang/go" {
+		t.Errorf("Expected only the English result to be kept, got %d removed and %+v", removed, kept)
+	}
+}
+
+func TestParseResultLanguage(t *testing.T) {
+	if code, err := ParseResultLanguage(map[string]any{}); err != nil || code != "" {
+		t.Errorf("Expected no filter by default, got %q (%v)", code, err)
+	}
+	if code, err := ParseResultLanguage(map[string]any{"result_language": " DE "}); err != nil || code != "de" {
+		t.Errorf("Expected a normalised code, got %q (%v)", code, err)
+	}
+	_, err := ParseResultLanguage(map[string]any{"result_language": "english"})
+	if err == nil || !strings.Contains(err.Error(), "en, es") {
+		t.Errorf("Expected an error listing the supported codes, got %v", err)
+	}
+}
+
+func TestFilterLanguage(t *testing.T) {
+	language := func(code string, confidence float64) map[string]any {
+		return map[string]any{"detected_language": map[string]any{"code": code, "confidence": confidence}}
+	}
+	results := []SearchResult{
+		{URL: "https://a.example/", Metadata: language("en", 0.9)},
+		{URL: "https://b.example/", Metadata: language("de", 0.9)},
+		{URL: "https://c.example/", Metadata: language("en", 0.3)},
+		{URL: "https://d.example/", Metadata: map[string]any{}},
+	}
+
+	kept, removed := FilterLanguage(results, "en")
+	if removed != 2 || len(kept) != 2 || kept[0].URL != "https://a.example/" || kept[1].URL != "https://d.example/" {
+		t.Errorf("Expected the confident English and undetected results to be kept, got %d removed and %+v", removed, kept)
+	}
+}
+
+func BenchmarkDetectLanguage(b *testing.B) {
+	// A typical title and snippet, so detection over a page of results stays well under a millisecond
+	text := "An Introduction To Generics - The Go Programming Language. The Go 1.18 release adds support for generics. " +
+		"Generics are the biggest change we've made to Go since the first open source release."
+	getLatinModel()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		DetectLanguage(text)
+	}
+}
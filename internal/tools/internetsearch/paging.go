@@ -0,0 +1,56 @@
+package internetsearch
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// PageFunc fetches one page of results, given its zero-based index and how many results are still needed.
+// It returns how many results the page contributed and whether the provider may have a further page.
+type PageFunc func(page, remaining int) (added int, more bool, err error)
+
+// PageStats records how a paged provider assembled its results
+type PageStats struct {
+	RequestedCount int
+	PagesFetched   int
+}
+
+// FetchPages calls fetch for successive pages until count results are collected, maxPages pages have been
+// fetched or the provider runs out of pages. A failure on the first page is returned, while a later one
+// stops paging so the results already collected are kept.
+func FetchPages(logger *logrus.Logger, provider string, count, maxPages int, fetch PageFunc) (PageStats, error) {
+	stats := PageStats{RequestedCount: count}
+	collected := 0
+
+	for page := 0; page < maxPages && collected < count; page++ {
+		added, more, err := fetch(page, count-collected)
+		if err != nil {
+			if page == 0 {
+				return stats, err
+			}
+			logger.WithError(err).WithFields(logrus.Fields{
+				"provider": provider,
+				"page":     page + 1,
+			}).Warn("Failed to fetch additional results page, returning partial results")
+			break
+		}
+
+		stats.PagesFetched++
+		collected += added
+		if !more {
+			break
+		}
+	}
+
+	return stats, nil
+}
+
+// Apply records the requested and returned result counts and the pages fetched in the response metadata,
+// so callers can tell when a provider had fewer results than requested
+func (s PageStats) Apply(response *SearchResponse) {
+	if response == nil {
+		return
+	}
+	response.SetMetadata("requested_count", s.RequestedCount)
+	response.SetMetadata("result_count", len(response.Results))
+	response.SetMetadata("pages_fetched", s.PagesFetched)
+}
@@ -0,0 +1,73 @@
+package internetsearch
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestFetchPages(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	errPage := errors.New("page unavailable")
+
+	tests := []struct {
+		name      string
+		pageSizes []int // Results each page contributes, with the provider out of pages after the last
+		failPage  int   // Zero-based page that fails, or -1
+		count     int
+		maxPages  int
+		wantPages int
+		wantErr   bool
+	}{
+		{name: "single page meets count", pageSizes: []int{10, 10}, failPage: -1, count: 10, maxPages: 3, wantPages: 1},
+		{name: "pages until count is met", pageSizes: []int{4, 4, 4, 4}, failPage: -1, count: 10, maxPages: 5, wantPages: 3},
+		{name: "bounded by max pages", pageSizes: []int{2, 2, 2, 2}, failPage: -1, count: 10, maxPages: 2, wantPages: 2},
+		{name: "provider out of pages", pageSizes: []int{3, 3}, failPage: -1, count: 10, maxPages: 5, wantPages: 2},
+		{name: "first page failure returned", pageSizes: []int{3, 3}, failPage: 0, count: 10, maxPages: 5, wantErr: true},
+		{name: "later page failure keeps results", pageSizes: []int{3, 3, 3}, failPage: 1, count: 10, maxPages: 5, wantPages: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var remainders []int
+			stats, err := FetchPages(logger, "test", tt.count, tt.maxPages, func(page, remaining int) (int, bool, error) {
+				remainders = append(remainders, remaining)
+				if page == tt.failPage {
+					return 0, false, errPage
+				}
+				return min(tt.pageSizes[page], remaining), page < len(tt.pageSizes)-1, nil
+			})
+
+			if tt.wantErr {
+				if !errors.Is(err, errPage) {
+					t.Errorf("Expected the first page error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+			if stats.RequestedCount != tt.count || stats.PagesFetched != tt.wantPages {
+				t.Errorf("Expected %d pages for count %d, got %+v", tt.wantPages, tt.count, stats)
+			}
+			if remainders[0] != tt.count {
+				t.Errorf("Expected the first page to be asked for the full count, got %d", remainders[0])
+			}
+		})
+	}
+}
+
+func TestPageStats_Apply(t *testing.T) {
+	response := &SearchResponse{Results: []SearchResult{{URL: "https://go.dev/"}, {URL: "https://pkg.go.dev/"}}}
+	PageStats{RequestedCount: 5, PagesFetched: 2}.Apply(response)
+
+	if response.Metadata["requested_count"] != 5 || response.Metadata["result_count"] != 2 || response.Metadata["pages_fetched"] != 2 {
+		t.Errorf("Unexpected paging metadata: %v", response.Metadata)
+	}
+
+	// A nil response is ignored rather than panicking
+	PageStats{}.Apply(nil)
+}
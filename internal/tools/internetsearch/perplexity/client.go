@@ -0,0 +1,159 @@
+package perplexity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// PerplexityAPIBaseURL is the base URL for the Perplexity API
+	PerplexityAPIBaseURL = "https://api.perplexity.ai"
+
+	// UserAgent for API requests
+	UserAgent = "mcp-devtools/1.0"
+)
+
+// PerplexityClient handles HTTP requests to the Perplexity API
+type PerplexityClient struct {
+	apiKey     string
+	model      string
+	httpClient internetsearch.HTTPClientInterface
+	baseURL    string
+}
+
+// NewPerplexityClient creates a new Perplexity API client with rate limiting
+func NewPerplexityClient(apiKey, model string) *PerplexityClient {
+	return &PerplexityClient{
+		apiKey:     apiKey,
+		model:      model,
+		baseURL:    PerplexityAPIBaseURL,
+		httpClient: internetsearch.NewRateLimitedHTTPClient(),
+	}
+}
+
+// Ask submits a query to the Perplexity online model and returns its answer with citations.
+// recency optionally limits the sources searched to the past day, week or month.
+func (c *PerplexityClient) Ask(ctx context.Context, logger *logrus.Logger, query, recency string) (*PerplexityChatResponse, error) {
+	reqURL, err := url.Parse(c.baseURL + "/chat/completions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Check domain access security for API endpoint using security helper
+	if err := security.CheckDomainAccess(reqURL.Hostname()); err != nil {
+		if secErr, ok := err.(*security.SecurityError); ok {
+			return nil, security.FormatSecurityBlockError(secErr)
+		}
+		return nil, err
+	}
+
+	payload, err := json.Marshal(PerplexityChatRequest{
+		Model: c.model,
+		Messages: []PerplexityMessage{
+			{Role: "user", Content: query},
+		},
+		SearchRecencyFilter: recency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", reqURL.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("User-Agent", UserAgent)
+
+	logger.WithFields(logrus.Fields{
+		"url":   reqURL.String(),
+		"model": c.model,
+	}).Debug("Making Perplexity API request")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("Failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response body: %w", internetsearch.ErrNetwork, err))
+	}
+	internetsearch.CaptureResponse(ctx, logger, "perplexity", resp, body)
+
+	// Security analysis on content
+	if security.IsEnabled() {
+		sourceCtx := security.SourceContext{
+			URL:         reqURL.String(),
+			Domain:      reqURL.Hostname(),
+			ContentType: resp.Header.Get("Content-Type"),
+			Tool:        "internetsearch",
+		}
+
+		if secResult, err := security.AnalyseContent(string(body), sourceCtx); err == nil {
+			switch secResult.Action {
+			case security.ActionBlock:
+				return nil, security.FormatSecurityBlockErrorFromResult(secResult)
+			case security.ActionWarn:
+				logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
+			}
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		logger.WithFields(logrus.Fields{
+			"status_code": resp.StatusCode,
+			"status":      resp.Status,
+		}).Error("Perplexity API request failed")
+
+		switch resp.StatusCode {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("%w: invalid Perplexity API key", internetsearch.ErrAuth)
+		case http.StatusTooManyRequests:
+			return nil, &internetsearch.RateLimitError{
+				Provider:   "perplexity",
+				RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			}
+		}
+
+		var errorResp PerplexityErrorResponse
+		if err := json.Unmarshal(body, &errorResp); err == nil && errorResp.Error.Message != "" {
+			return nil, fmt.Errorf("perplexity API error (%d): %s", resp.StatusCode, errorResp.Error.Message)
+		}
+		return nil, fmt.Errorf("perplexity API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response PerplexityChatResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse chat response: %w", internetsearch.ErrParse, err)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"model":             response.Model,
+		"prompt_tokens":     response.Usage.PromptTokens,
+		"completion_tokens": response.Usage.CompletionTokens,
+		"total_tokens":      response.Usage.TotalTokens,
+	}).Info("Perplexity token usage")
+
+	return &response, nil
+}
@@ -0,0 +1,187 @@
+package perplexity
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultModel is the Perplexity online model used when PERPLEXITY_MODEL is not set
+	DefaultModel = "sonar"
+)
+
+// PerplexityProvider implements the unified SearchProvider interface
+type PerplexityProvider struct {
+	client *PerplexityClient
+}
+
+// NewPerplexityProvider creates a new Perplexity answer provider
+func NewPerplexityProvider() *PerplexityProvider {
+	apiKey := strings.TrimSpace(os.Getenv("PERPLEXITY_API_KEY"))
+	if apiKey == "" {
+		return nil
+	}
+
+	model := strings.TrimSpace(os.Getenv("PERPLEXITY_MODEL"))
+	if model == "" {
+		model = DefaultModel
+	}
+
+	return &PerplexityProvider{
+		client: NewPerplexityClient(apiKey, model),
+	}
+}
+
+// GetName returns the provider name
+func (p *PerplexityProvider) GetName() string {
+	return "perplexity"
+}
+
+// IsAvailable checks if the provider is available
+func (p *PerplexityProvider) IsAvailable() bool {
+	return p.client != nil && strings.TrimSpace(os.Getenv("PERPLEXITY_API_KEY")) != ""
+}
+
+// GetSupportedTypes returns the search types this provider supports
+func (p *PerplexityProvider) GetSupportedTypes() []string {
+	// Perplexity returns a synthesised answer rather than a list of links, so it only serves answer requests
+	return []string{"answer"}
+}
+
+// Describe returns how the provider is configured, for provider listings
+func (p *PerplexityProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "Perplexity Sonar API answering questions with cited sources via the answer type",
+		RequiredEnvVars: []string{"PERPLEXITY_API_KEY"},
+		OptionalEnvVars: []string{"PERPLEXITY_MODEL"},
+	}
+}
+
+// Search executes a search using the Perplexity provider
+func (p *PerplexityProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	logger.WithFields(logrus.Fields{
+		"provider": "perplexity",
+		"type":     searchType,
+		"query":    query,
+	}).Debug("Perplexity search parameters")
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *internetsearch.SearchResponse
+	switch searchType {
+	case "answer":
+		response, err = p.executeAnswerSearch(ctx, logger, args, recencyFilter(timeRange))
+	default:
+		return nil, fmt.Errorf("unsupported search type for Perplexity: %s", searchType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response.ApplyTimeRange(timeRange, timeRange == "" || recencyFilter(timeRange) != "")
+	return response, nil
+}
+
+// recencyFilter maps a time range to Perplexity's search_recency_filter, which has no year option
+func recencyFilter(timeRange string) string {
+	switch timeRange {
+	case internetsearch.TimeRangeDay, internetsearch.TimeRangeWeek, internetsearch.TimeRangeMonth:
+		return timeRange
+	default:
+		return ""
+	}
+}
+
+// executeAnswerSearch submits the query and converts the answer and citations to unified results
+func (p *PerplexityProvider) executeAnswerSearch(ctx context.Context, logger *logrus.Logger, args map[string]any, recency string) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	response, err := p.client.Ask(ctx, logger, query, recency)
+	if err != nil {
+		return nil, fmt.Errorf("answer search failed: %w", err)
+	}
+
+	if len(response.Choices) == 0 || strings.TrimSpace(response.Choices[0].Message.Content) == "" {
+		return p.createEmptyResponse()
+	}
+
+	results := make([]internetsearch.SearchResult, 0, len(response.Citations)+1)
+	results = append(results, internetsearch.SearchResult{
+		Title:       fmt.Sprintf("Answer: %s", query),
+		Description: strings.TrimSpace(response.Choices[0].Message.Content),
+		Type:        "answer",
+		Metadata: map[string]any{
+			"provider": "perplexity",
+			"model":    response.Model,
+		},
+	})
+
+	// Index search result details by URL so citations can carry titles and dates
+	sourceDetails := make(map[string]PerplexitySearchResult, len(response.SearchResults))
+	for _, source := range response.SearchResults {
+		sourceDetails[source.URL] = source
+	}
+
+	for i, citation := range response.Citations {
+		metadata := map[string]any{
+			"position": i + 1,
+		}
+
+		title := citation
+		if source, ok := sourceDetails[citation]; ok {
+			if source.Title != "" {
+				title = source.Title
+			}
+			if source.Date != "" {
+				metadata["published"] = source.Date
+			}
+		}
+
+		results = append(results, internetsearch.SearchResult{
+			Title:       title,
+			URL:         citation,
+			Description: fmt.Sprintf("Source [%d] cited in the answer", i+1),
+			Type:        "citation",
+			Metadata:    metadata,
+		})
+	}
+
+	return p.createSuccessResponse(query, results, logger)
+}
+
+// Helper functions
+func (p *PerplexityProvider) createEmptyResponse() (*internetsearch.SearchResponse, error) {
+	result := &internetsearch.SearchResponse{
+		Results:   []internetsearch.SearchResult{},
+		Provider:  "perplexity",
+		Timestamp: time.Now(),
+	}
+	return result, nil
+}
+
+func (p *PerplexityProvider) createSuccessResponse(query string, results []internetsearch.SearchResult, logger *logrus.Logger) (*internetsearch.SearchResponse, error) {
+	result := &internetsearch.SearchResponse{
+		Results:   results,
+		Provider:  "perplexity",
+		Timestamp: time.Now(),
+	}
+
+	logger.WithFields(logrus.Fields{
+		"query":        query,
+		"result_count": len(results),
+		"provider":     "perplexity",
+	}).Info("Perplexity search completed successfully")
+
+	return result, nil
+}
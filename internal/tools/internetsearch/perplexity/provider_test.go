@@ -0,0 +1,145 @@
+package perplexity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const perplexityChatFixture = `{
+  "id": "chatcmpl-123",
+  "model": "sonar",
+  "choices": [
+    {
+      "index": 0,
+      "message": {"role": "assistant", "content": "Go 1.23 added range-over-func iterators [1][2]."},
+      "finish_reason": "stop"
+    }
+  ],
+  "citations": [
+    "https://go.dev/blog/go1.23",
+    "https://go.dev/doc/go1.23"
+  ],
+  "search_results": [
+    {"title": "Go 1.23 is released", "url": "https://go.dev/blog/go1.23", "date": "2024-08-13"}
+  ],
+  "usage": {"prompt_tokens": 12, "completion_tokens": 40, "total_tokens": 52}
+}`
+
+func TestPerplexityProvider_AnswerWithCitations(t *testing.T) {
+	var received PerplexityChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" {
+			t.Errorf("Expected /chat/completions, got %s", r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(perplexityChatFixture))
+	}))
+	defer server.Close()
+
+	provider := &PerplexityProvider{
+		client: &PerplexityClient{
+			apiKey:     "pplx-test",
+			model:      "sonar-pro",
+			baseURL:    server.URL,
+			httpClient: server.Client(),
+		},
+	}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "answer", map[string]any{"query": "what is new in go 1.23"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if received.Model != "sonar-pro" || len(received.Messages) != 1 || received.Messages[0].Content != "what is new in go 1.23" {
+		t.Errorf("Unexpected request body: %+v", received)
+	}
+
+	if len(response.Results) != 3 {
+		t.Fatalf("Expected answer plus 2 citations, got %d", len(response.Results))
+	}
+	if response.Results[0].Type != "answer" {
+		t.Errorf("Expected first result to be the answer, got type %q", response.Results[0].Type)
+	}
+
+	first := response.Results[1]
+	if first.Type != "citation" || first.Title != "Go 1.23 is released" || first.Metadata["position"] != 1 {
+		t.Errorf("Unexpected first citation: %+v", first)
+	}
+	second := response.Results[2]
+	if second.Title != "https://go.dev/doc/go1.23" || second.Metadata["position"] != 2 {
+		t.Errorf("Expected citation without search result details to fall back to URL title, got %+v", second)
+	}
+}
+
+func TestPerplexityProvider_OnlySupportsAnswer(t *testing.T) {
+	provider := &PerplexityProvider{client: NewPerplexityClient("pplx-test", DefaultModel)}
+
+	types := provider.GetSupportedTypes()
+	if len(types) != 1 || types[0] != "answer" {
+		t.Errorf("Expected only 'answer' type, got %v", types)
+	}
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go"}); err == nil {
+		t.Error("Expected error for web search type")
+	}
+}
+
+func TestPerplexityProvider_ModelFromEnv(t *testing.T) {
+	t.Setenv("PERPLEXITY_API_KEY", "pplx-test")
+
+	t.Setenv("PERPLEXITY_MODEL", "")
+	if provider := NewPerplexityProvider(); provider == nil || provider.client.model != DefaultModel {
+		t.Errorf("Expected default model %q", DefaultModel)
+	}
+
+	t.Setenv("PERPLEXITY_MODEL", "sonar-reasoning")
+	if provider := NewPerplexityProvider(); provider == nil || provider.client.model != "sonar-reasoning" {
+		t.Error("Expected model to be read from PERPLEXITY_MODEL")
+	}
+
+	if err := os.Unsetenv("PERPLEXITY_API_KEY"); err != nil {
+		t.Fatal(err)
+	}
+	if provider := NewPerplexityProvider(); provider != nil {
+		t.Error("Expected nil provider when PERPLEXITY_API_KEY is not set")
+	}
+}
+
+func TestPerplexityProvider_TimeRange(t *testing.T) {
+	var received PerplexityChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = PerplexityChatRequest{}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(perplexityChatFixture))
+	}))
+	defer server.Close()
+
+	provider := &PerplexityProvider{
+		client: &PerplexityClient{apiKey: "pplx-test", model: DefaultModel, baseURL: server.URL, httpClient: server.Client()},
+	}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "answer", map[string]any{"query": "go", "time_range": "week"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.SearchRecencyFilter != "week" || response.TimeRange != "week" {
+		t.Errorf("Expected week recency filter to be applied, got request %q response %q", received.SearchRecencyFilter, response.TimeRange)
+	}
+
+	// Perplexity has no year recency filter
+	response, err = provider.Search(context.Background(), testutils.DiscardLogger(), "answer", map[string]any{"query": "go", "time_range": "year"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.SearchRecencyFilter != "" {
+		t.Errorf("Expected no recency filter for year, got %q", received.SearchRecencyFilter)
+	}
+	if _, ok := response.Metadata["time_range_unsupported"]; !ok {
+		t.Errorf("Expected time_range_unsupported metadata, got %v", response.Metadata)
+	}
+}
@@ -0,0 +1,54 @@
+package perplexity
+
+// PerplexityChatRequest represents the request body for the Perplexity chat completions API
+type PerplexityChatRequest struct {
+	Model               string              `json:"model"`
+	Messages            []PerplexityMessage `json:"messages"`
+	SearchRecencyFilter string              `json:"search_recency_filter,omitempty"`
+}
+
+// PerplexityMessage represents a single chat message
+type PerplexityMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// PerplexityChatResponse represents the response from the Perplexity chat completions API
+type PerplexityChatResponse struct {
+	ID            string                   `json:"id"`
+	Model         string                   `json:"model"`
+	Choices       []PerplexityChoice       `json:"choices"`
+	Citations     []string                 `json:"citations,omitempty"`
+	SearchResults []PerplexitySearchResult `json:"search_results,omitempty"`
+	Usage         PerplexityUsage          `json:"usage"`
+}
+
+// PerplexityChoice represents a single completion choice
+type PerplexityChoice struct {
+	Index        int               `json:"index"`
+	Message      PerplexityMessage `json:"message"`
+	FinishReason string            `json:"finish_reason,omitempty"`
+}
+
+// PerplexitySearchResult contains details of a source used for the answer
+type PerplexitySearchResult struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	Date  string `json:"date,omitempty"`
+}
+
+// PerplexityUsage contains token usage information
+type PerplexityUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// PerplexityErrorResponse represents an error response from the Perplexity API
+type PerplexityErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Type    string `json:"type,omitempty"`
+		Code    int    `json:"code,omitempty"`
+	} `json:"error"`
+}
@@ -0,0 +1,129 @@
+package internetsearch
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Region describes a search region using DuckDuckGo's region codes as the canonical form,
+// along with the ISO codes other providers use for their market and locale parameters
+type Region struct {
+	Code     string // DuckDuckGo region code, e.g. "us-en"
+	Name     string // Human readable name
+	Country  string // ISO 3166-1 alpha-2 country code (upper-case), empty for multi-country regions
+	Language string // ISO 639-1 language code
+}
+
+// regions contains the region codes accepted by DuckDuckGo's kl parameter.
+// DuckDuckGo's codes are not strictly ISO (e.g. "uk-en", "jp-jp", "tw-tzh"), so each
+// entry records the ISO equivalents explicitly.
+var regions = map[string]Region{
+	"wt-wt":  {Code: "wt-wt", Name: "No region"},
+	"xa-ar":  {Code: "xa-ar", Name: "Arabia", Language: "ar"},
+	"xa-en":  {Code: "xa-en", Name: "Arabia (en)", Language: "en"},
+	"ar-es":  {Code: "ar-es", Name: "Argentina", Country: "AR", Language: "es"},
+	"au-en":  {Code: "au-en", Name: "Australia", Country: "AU", Language: "en"},
+	"at-de":  {Code: "at-de", Name: "Austria", Country: "AT", Language: "de"},
+	"be-fr":  {Code: "be-fr", Name: "Belgium (fr)", Country: "BE", Language: "fr"},
+	"be-nl":  {Code: "be-nl", Name: "Belgium (nl)", Country: "BE", Language: "nl"},
+	"br-pt":  {Code: "br-pt", Name: "Brazil", Country: "BR", Language: "pt"},
+	"bg-bg":  {Code: "bg-bg", Name: "Bulgaria", Country: "BG", Language: "bg"},
+	"ca-en":  {Code: "ca-en", Name: "Canada", Country: "CA", Language: "en"},
+	"ca-fr":  {Code: "ca-fr", Name: "Canada (fr)", Country: "CA", Language: "fr"},
+	"ct-ca":  {Code: "ct-ca", Name: "Catalan", Country: "ES", Language: "ca"},
+	"cl-es":  {Code: "cl-es", Name: "Chile", Country: "CL", Language: "es"},
+	"cn-zh":  {Code: "cn-zh", Name: "China", Country: "CN", Language: "zh"},
+	"co-es":  {Code: "co-es", Name: "Colombia", Country: "CO", Language: "es"},
+	"hr-hr":  {Code: "hr-hr", Name: "Croatia", Country: "HR", Language: "hr"},
+	"cz-cs":  {Code: "cz-cs", Name: "Czech Republic", Country: "CZ", Language: "cs"},
+	"dk-da":  {Code: "dk-da", Name: "Denmark", Country: "DK", Language: "da"},
+	"ee-et":  {Code: "ee-et", Name: "Estonia", Country: "EE", Language: "et"},
+	"fi-fi":  {Code: "fi-fi", Name: "Finland", Country: "FI", Language: "fi"},
+	"fr-fr":  {Code: "fr-fr", Name: "France", Country: "FR", Language: "fr"},
+	"de-de":  {Code: "de-de", Name: "Germany", Country: "DE", Language: "de"},
+	"gr-el":  {Code: "gr-el", Name: "Greece", Country: "GR", Language: "el"},
+	"hk-tzh": {Code: "hk-tzh", Name: "Hong Kong", Country: "HK", Language: "zh"},
+	"hu-hu":  {Code: "hu-hu", Name: "Hungary", Country: "HU", Language: "hu"},
+	"in-en":  {Code: "in-en", Name: "India", Country: "IN", Language: "en"},
+	"id-id":  {Code: "id-id", Name: "Indonesia", Country: "ID", Language: "id"},
+	"id-en":  {Code: "id-en", Name: "Indonesia (en)", Country: "ID", Language: "en"},
+	"ie-en":  {Code: "ie-en", Name: "Ireland", Country: "IE", Language: "en"},
+	"il-he":  {Code: "il-he", Name: "Israel", Country: "IL", Language: "he"},
+	"it-it":  {Code: "it-it", Name: "Italy", Country: "IT", Language: "it"},
+	"jp-jp":  {Code: "jp-jp", Name: "Japan", Country: "JP", Language: "ja"},
+	"kr-kr":  {Code: "kr-kr", Name: "Korea", Country: "KR", Language: "ko"},
+	"lv-lv":  {Code: "lv-lv", Name: "Latvia", Country: "LV", Language: "lv"},
+	"lt-lt":  {Code: "lt-lt", Name: "Lithuania", Country: "LT", Language: "lt"},
+	"xl-es":  {Code: "xl-es", Name: "Latin America", Language: "es"},
+	"my-ms":  {Code: "my-ms", Name: "Malaysia", Country: "MY", Language: "ms"},
+	"my-en":  {Code: "my-en", Name: "Malaysia (en)", Country: "MY", Language: "en"},
+	"mx-es":  {Code: "mx-es", Name: "Mexico", Country: "MX", Language: "es"},
+	"nl-nl":  {Code: "nl-nl", Name: "Netherlands", Country: "NL", Language: "nl"},
+	"nz-en":  {Code: "nz-en", Name: "New Zealand", Country: "NZ", Language: "en"},
+	"no-no":  {Code: "no-no", Name: "Norway", Country: "NO", Language: "no"},
+	"pe-es":  {Code: "pe-es", Name: "Peru", Country: "PE", Language: "es"},
+	"ph-en":  {Code: "ph-en", Name: "Philippines", Country: "PH", Language: "en"},
+	"ph-tl":  {Code: "ph-tl", Name: "Philippines (tl)", Country: "PH", Language: "tl"},
+	"pl-pl":  {Code: "pl-pl", Name: "Poland", Country: "PL", Language: "pl"},
+	"pt-pt":  {Code: "pt-pt", Name: "Portugal", Country: "PT", Language: "pt"},
+	"ro-ro":  {Code: "ro-ro", Name: "Romania", Country: "RO", Language: "ro"},
+	"ru-ru":  {Code: "ru-ru", Name: "Russia", Country: "RU", Language: "ru"},
+	"sg-en":  {Code: "sg-en", Name: "Singapore", Country: "SG", Language: "en"},
+	"sk-sk":  {Code: "sk-sk", Name: "Slovak Republic", Country: "SK", Language: "sk"},
+	"sl-sl":  {Code: "sl-sl", Name: "Slovenia", Country: "SI", Language: "sl"},
+	"za-en":  {Code: "za-en", Name: "South Africa", Country: "ZA", Language: "en"},
+	"es-es":  {Code: "es-es", Name: "Spain", Country: "ES", Language: "es"},
+	"se-sv":  {Code: "se-sv", Name: "Sweden", Country: "SE", Language: "sv"},
+	"ch-de":  {Code: "ch-de", Name: "Switzerland (de)", Country: "CH", Language: "de"},
+	"ch-fr":  {Code: "ch-fr", Name: "Switzerland (fr)", Country: "CH", Language: "fr"},
+	"ch-it":  {Code: "ch-it", Name: "Switzerland (it)", Country: "CH", Language: "it"},
+	"tw-tzh": {Code: "tw-tzh", Name: "Taiwan", Country: "TW", Language: "zh"},
+	"th-th":  {Code: "th-th", Name: "Thailand", Country: "TH", Language: "th"},
+	"tr-tr":  {Code: "tr-tr", Name: "Turkey", Country: "TR", Language: "tr"},
+	"ua-uk":  {Code: "ua-uk", Name: "Ukraine", Country: "UA", Language: "uk"},
+	"uk-en":  {Code: "uk-en", Name: "United Kingdom", Country: "GB", Language: "en"},
+	"us-en":  {Code: "us-en", Name: "United States", Country: "US", Language: "en"},
+	"us-es":  {Code: "us-es", Name: "United States (es)", Country: "US", Language: "es"},
+	"ve-es":  {Code: "ve-es", Name: "Venezuela", Country: "VE", Language: "es"},
+	"vn-vi":  {Code: "vn-vi", Name: "Vietnam", Country: "VN", Language: "vi"},
+}
+
+// RegionCodes returns all supported region codes in sorted order
+func RegionCodes() []string {
+	codes := make([]string, 0, len(regions))
+	for code := range regions {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// ParseRegion reads and validates the optional region argument.
+// It returns nil when no region was requested.
+func ParseRegion(args map[string]any) (*Region, error) {
+	raw, ok := args["region"].(string)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	code := strings.ToLower(strings.TrimSpace(raw))
+	region, exists := regions[code]
+	if !exists {
+		return nil, fmt.Errorf("invalid region %q, valid regions are: %s", raw, strings.Join(RegionCodes(), ", "))
+	}
+
+	return &region, nil
+}
+
+// Locale returns the region as a BCP 47 language tag, e.g. "en-US", or just the language when
+// the region spans multiple countries
+func (r *Region) Locale() string {
+	if r.Language == "" {
+		return ""
+	}
+	if r.Country == "" {
+		return r.Language
+	}
+	return r.Language + "-" + r.Country
+}
@@ -0,0 +1,169 @@
+package internetsearch
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultRetryAttempts is the default maximum number of attempts for a search request
+	DefaultRetryAttempts = 3
+	// RetryAttemptsEnvVar is the environment variable for configuring the maximum attempts
+	RetryAttemptsEnvVar = "INTERNET_SEARCH_RETRY_ATTEMPTS"
+)
+
+// RetryPolicy controls how transient search failures are retried
+type RetryPolicy struct {
+	MaxAttempts int           // Total attempts including the first, values below 1 mean a single attempt
+	BaseDelay   time.Duration // Delay before the first retry, doubled for each subsequent retry
+	MaxDelay    time.Duration // Upper bound for a single wait, including a server requested Retry-After
+}
+
+// RetryStats records how many attempts a request took and how long was spent waiting between them
+type RetryStats struct {
+	Attempts  int
+	TotalWait time.Duration
+}
+
+// RetryableError marks an error as transient, e.g. a network failure or a 5xx response
+type RetryableError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *RetryableError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *RetryableError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable wraps an error so Retry will try the request again
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &RetryableError{Err: err}
+}
+
+// DefaultRetryPolicy returns the retry policy used by providers, honouring INTERNET_SEARCH_RETRY_ATTEMPTS
+func DefaultRetryPolicy() RetryPolicy {
+	attempts := DefaultRetryAttempts
+	if envValue := os.Getenv(RetryAttemptsEnvVar); envValue != "" {
+		if value, err := strconv.Atoi(envValue); err == nil && value > 0 {
+			attempts = value
+		}
+	}
+
+	return RetryPolicy{
+		MaxAttempts: attempts,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+	}
+}
+
+// IsRetryable reports whether an error is worth retrying: transient errors and rate limits are,
+// context cancellation and everything else are not
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var retryableErr *RetryableError
+	var rateLimitErr *RateLimitError
+	return errors.As(err, &retryableErr) || errors.As(err, &rateLimitErr)
+}
+
+// Retry calls fn until it succeeds, returns a non-retryable error or the policy's attempts are used up.
+// Waits use exponential backoff with jitter, or the provider's Retry-After when it is longer.
+// A rate limit asking for a longer wait than MaxDelay is returned immediately rather than blocking the call.
+func Retry(ctx context.Context, logger *logrus.Logger, policy RetryPolicy, fn func() error) (RetryStats, error) {
+	maxAttempts := max(policy.MaxAttempts, 1)
+	var stats RetryStats
+
+	for {
+		stats.Attempts++
+		err := fn()
+		if err == nil || !IsRetryable(err) || stats.Attempts >= maxAttempts || ctx.Err() != nil {
+			logRetryStats(logger, stats, err)
+			return stats, err
+		}
+
+		wait := policy.backoff(stats.Attempts)
+		var rateLimitErr *RateLimitError
+		if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > wait {
+			if policy.MaxDelay > 0 && rateLimitErr.RetryAfter > policy.MaxDelay {
+				logRetryStats(logger, stats, err)
+				return stats, err
+			}
+			wait = rateLimitErr.RetryAfter
+		}
+
+		logger.WithFields(logrus.Fields{
+			"attempt": stats.Attempts,
+			"wait":    wait,
+			"error":   err.Error(),
+		}).Debug("Retrying search request after transient failure")
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logRetryStats(logger, stats, ctx.Err())
+			return stats, ctx.Err()
+		case <-timer.C:
+		}
+		stats.TotalWait += wait
+	}
+}
+
+// backoff returns the wait before the given retry, doubling from BaseDelay with up to 50% jitter
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay << (attempt - 1)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	delay += time.Duration(rand.Int64N(int64(delay)/2 + 1))
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// Apply records the retry stats in the response metadata when the request needed more than one attempt
+func (s RetryStats) Apply(response *SearchResponse) {
+	if response == nil || s.Attempts <= 1 {
+		return
+	}
+	response.SetMetadata("retry_attempts", s.Attempts)
+	response.SetMetadata("retry_wait", s.TotalWait.Round(time.Millisecond).String())
+}
+
+// logRetryStats logs the outcome of a retried request at debug level
+func logRetryStats(logger *logrus.Logger, stats RetryStats, err error) {
+	if stats.Attempts <= 1 {
+		return
+	}
+
+	entry := logger.WithFields(logrus.Fields{
+		"attempts":   stats.Attempts,
+		"total_wait": stats.TotalWait,
+	})
+	if err != nil {
+		entry.WithError(err).Debug("Search request failed after retries")
+		return
+	}
+	entry.Debug("Search request succeeded after retries")
+}
@@ -17,6 +17,13 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// safeSearchValues maps safe search levels to SearXNG's numeric safesearch values
+var safeSearchValues = map[string]string{
+	internetsearch.SafeSearchOff:      "0",
+	internetsearch.SafeSearchModerate: "1",
+	internetsearch.SafeSearchStrict:   "2",
+}
+
 // SearXNGProvider implements the unified SearchProvider interface
 type SearXNGProvider struct {
 	baseURL  string
@@ -32,9 +39,11 @@ type SearXNGResponse struct {
 
 // SearXNGResult represents a single search result from SearXNG
 type SearXNGResult struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
-	URL     string `json:"url"`
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	URL     string   `json:"url"`
+	Engine  string   `json:"engine,omitempty"`
+	Engines []string `json:"engines,omitempty"`
 }
 
 // NewSearXNGProvider creates a new SearXNG search provider
@@ -74,6 +83,15 @@ func (p *SearXNGProvider) GetSupportedTypes() []string {
 	return []string{"web", "image", "news", "video"}
 }
 
+// Describe returns how the provider is configured, for provider listings
+func (p *SearXNGProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "Self-hosted SearXNG instance with web, image, news and video search",
+		RequiredEnvVars: []string{"SEARXNG_BASE_URL"},
+		OptionalEnvVars: []string{"SEARXNG_USERNAME", "SEARXNG_PASSWORD"},
+	}
+}
+
 // Search executes a search using the SearXNG provider
 func (p *SearXNGProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
@@ -85,12 +103,31 @@ func (p *SearXNGProvider) Search(ctx context.Context, logger *logrus.Logger, sea
 		"baseURL":  p.baseURL,
 	}).Debug("SearXNG search parameters")
 
+	region, err := internetsearch.ParseRegion(args)
+	if err != nil {
+		return nil, err
+	}
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
 	// For SearXNG, all search types are handled as internet search with different categories
-	return p.executeSearch(ctx, logger, searchType, args)
+	response, err := p.executeSearch(ctx, logger, searchType, args, region, timeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	if region != nil {
+		response.Region = region.Code
+	}
+	response.ApplyTimeRange(timeRange, true)
+	return response, nil
 }
 
 // executeSearch handles the actual search execution
-func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any, region *internetsearch.Region, timeRange string) (*internetsearch.SearchResponse, error) {
 	query := args["query"].(string)
 
 	// Parse SearXNG-specific parameters
@@ -99,24 +136,19 @@ func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logg
 		pageno = max(int(pagenoRaw), 1)
 	}
 
-	timeRange := ""
-	if timeRangeRaw, ok := args["time_range"].(string); ok {
-		if timeRangeRaw == "day" || timeRangeRaw == "month" || timeRangeRaw == "year" {
-			timeRange = timeRangeRaw
-		}
-	}
-
+	// An explicit language takes precedence over the locale derived from the region
 	language := "all"
 	if languageRaw, ok := args["language"].(string); ok && languageRaw != "" {
 		language = languageRaw
+	} else if region != nil && region.Locale() != "" {
+		language = region.Locale()
 	}
 
-	safesearch := "0"
-	if safesearchRaw, ok := args["safesearch"].(string); ok {
-		if safesearchRaw == "0" || safesearchRaw == "1" || safesearchRaw == "2" {
-			safesearch = safesearchRaw
-		}
+	safeSearchLevel, err := internetsearch.ParseSafeSearch(args)
+	if err != nil {
+		return nil, err
 	}
+	safesearch := safeSearchValues[safeSearchLevel]
 
 	// Build search URL
 	searchURL, err := url.Parse(p.baseURL + "/search")
@@ -180,7 +212,10 @@ func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logg
 	// Execute request using rate-limited client
 	resp, err := p.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("search request failed: %w", err)
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -191,8 +226,9 @@ func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logg
 	// Read response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response body: %w", internetsearch.ErrNetwork, err))
 	}
+	internetsearch.CaptureResponse(ctx, logger, "searxng", resp, body)
 
 	// Security analysis on content
 	if security.IsEnabled() {
@@ -213,14 +249,24 @@ func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logg
 		}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("SearXNG API error: %d %s", resp.StatusCode, resp.Status)
+	// A 401 is always the instance's basic auth, whatever the body
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("%w: SearXNG instance at %s rejected the credentials, check SEARXNG_USERNAME and SEARXNG_PASSWORD", internetsearch.ErrAuth, p.baseURL)
+	}
+
+	// Instances with the JSON format disabled respond with an HTML page (usually 403 Forbidden)
+	if isHTMLResponse(resp.Header.Get("Content-Type"), body) {
+		return nil, fmt.Errorf("SearXNG instance at %s returned HTML instead of JSON (status %d): enable the JSON output format by adding 'json' to search.formats in the instance's settings.yml", p.baseURL, resp.StatusCode)
+	}
+
+	if err := checkStatus(resp); err != nil {
+		return nil, err
 	}
 
 	// Parse response
 	var searxngResp SearXNGResponse
 	if err := json.Unmarshal(body, &searxngResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, fmt.Errorf("%w: failed to parse response: %w", internetsearch.ErrParse, err)
 	}
 
 	// Convert to unified format
@@ -232,6 +278,12 @@ func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logg
 	for _, searxngResult := range searxngResp.Results {
 		metadata := make(map[string]any)
 		metadata["category"] = searchType
+		if searxngResult.Engine != "" {
+			metadata["engine"] = searxngResult.Engine
+		}
+		if len(searxngResult.Engines) > 0 {
+			metadata["engines"] = searxngResult.Engines
+		}
 		if language != "all" {
 			metadata["language"] = language
 		}
@@ -250,6 +302,35 @@ func (p *SearXNGProvider) executeSearch(ctx context.Context, logger *logrus.Logg
 	return p.createSuccessResponse(query, results, logger), nil
 }
 
+// checkStatus converts a non-200 SearXNG response into an error, marking rate limits
+// and server errors as retryable
+func checkStatus(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("%w: SearXNG denied the search: status %d", internetsearch.ErrAuth, resp.StatusCode)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return &internetsearch.RateLimitError{
+			Provider:   "searxng",
+			RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return internetsearch.Retryable(fmt.Errorf("SearXNG API error: %s", resp.Status))
+	default:
+		return fmt.Errorf("SearXNG API error: %s", resp.Status)
+	}
+}
+
+// isHTMLResponse reports whether a response body is an HTML page rather than JSON
+func isHTMLResponse(contentType string, body []byte) bool {
+	if strings.Contains(strings.ToLower(contentType), "text/html") {
+		return true
+	}
+	trimmed := strings.ToLower(strings.TrimSpace(string(body)))
+	return strings.HasPrefix(trimmed, "<!doctype html") || strings.HasPrefix(trimmed, "<html")
+}
+
 // Helper functions
 func (p *SearXNGProvider) createEmptyResponse() *internetsearch.SearchResponse {
 	return &internetsearch.SearchResponse{
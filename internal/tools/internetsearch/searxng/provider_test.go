@@ -0,0 +1,281 @@
+package searxng
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const searxngJSONFixture = `{
+  "query": "golang",
+  "results": [
+    {
+      "title": "The Go Programming Language",
+      "url": "https://go.dev/",
+      "content": "Go is an open source programming language.",
+      "engine": "duckduckgo",
+      "engines": ["duckduckgo", "bing"]
+    },
+    {
+      "title": "Go (programming language) - Wikipedia",
+      "url": "https://en.wikipedia.org/wiki/Go_(programming_language)",
+      "content": "Go is a statically typed, compiled language.",
+      "engine": "wikipedia"
+    }
+  ]
+}`
+
+const searxngFormatDisabledFixture = `<!DOCTYPE html>
+<html>
+<head><title>403 Forbidden</title></head>
+<body><h1>Forbidden</h1><p>You don't have the permission to access the requested resource.</p></body>
+</html>`
+
+func TestSearXNGProvider_MapsResultsWithEngine(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(searxngJSONFixture))
+	}))
+	defer server.Close()
+
+	provider := &SearXNGProvider{
+		baseURL:  server.URL,
+		username: "user",
+		password: "secret",
+		client:   server.Client(),
+	}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{
+		"query":      "golang",
+		"pageno":     float64(2),
+		"language":   "en",
+		"time_range": "day",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	query := received.URL.Query()
+	if received.URL.Path != "/search" || query.Get("format") != "json" {
+		t.Errorf("Expected /search?format=json, got %s", received.URL.String())
+	}
+	if query.Get("categories") != "news" || query.Get("pageno") != "2" || query.Get("language") != "en" || query.Get("time_range") != "day" {
+		t.Errorf("Unexpected query parameters: %v", query)
+	}
+	if username, password, ok := received.BasicAuth(); !ok || username != "user" || password != "secret" {
+		t.Errorf("Expected basic auth credentials to be sent")
+	}
+
+	if len(response.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(response.Results))
+	}
+	if response.Results[0].Metadata["engine"] != "duckduckgo" {
+		t.Errorf("Expected engine metadata, got %v", response.Results[0].Metadata["engine"])
+	}
+	if response.Results[1].Metadata["engine"] != "wikipedia" {
+		t.Errorf("Expected engine metadata, got %v", response.Results[1].Metadata["engine"])
+	}
+}
+
+func TestSearXNGProvider_DetectsJSONFormatDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(searxngFormatDisabledFixture))
+	}))
+	defer server.Close()
+
+	provider := &SearXNGProvider{baseURL: server.URL, client: server.Client()}
+
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+	if err == nil {
+		t.Fatal("Expected error when instance returns HTML, got success")
+	}
+	if !strings.Contains(err.Error(), "search.formats") {
+		t.Errorf("Expected actionable error mentioning search.formats, got: %v", err)
+	}
+}
+
+func TestSearXNGProvider_ErrorClassification(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		check       func(error) bool
+	}{
+		{
+			name:        "basic auth rejected",
+			status:      http.StatusUnauthorized,
+			contentType: "text/html",
+			body:        "<html><body>401 Authorization Required</body></html>",
+			check:       func(err error) bool { return errors.Is(err, internetsearch.ErrAuth) },
+		},
+		{
+			name:   "forbidden",
+			status: http.StatusForbidden,
+			check:  func(err error) bool { return errors.Is(err, internetsearch.ErrAuth) },
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			check: func(err error) bool {
+				var rateLimitErr *internetsearch.RateLimitError
+				return errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter == 30*time.Second
+			},
+		},
+		{
+			name:   "server error",
+			status: http.StatusBadGateway,
+			check: func(err error) bool {
+				return internetsearch.IsRetryable(err) && !errors.Is(err, internetsearch.ErrAuth)
+			},
+		},
+		{
+			name:   "unparseable response",
+			status: http.StatusOK,
+			body:   `{"results": [`,
+			check:  func(err error) bool { return errors.Is(err, internetsearch.ErrParse) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", cmp.Or(tt.contentType, "application/json"))
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			provider := &SearXNGProvider{baseURL: server.URL, client: server.Client()}
+			_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+			if err == nil || !tt.check(err) {
+				t.Errorf("Unexpected classification for status %d: %v", tt.status, err)
+			}
+		})
+	}
+
+	// An unreachable instance is a retryable network error
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	provider := &SearXNGProvider{baseURL: server.URL, client: server.Client()}
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "golang"})
+	if !errors.Is(err, internetsearch.ErrNetwork) || !internetsearch.IsRetryable(err) {
+		t.Errorf("Expected a retryable network error, got %v", err)
+	}
+}
+
+func TestSearXNGProvider_RegionSetsLanguage(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(searxngJSONFixture))
+	}))
+	defer server.Close()
+
+	provider := &SearXNGProvider{baseURL: server.URL, client: server.Client()}
+
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":  "golang",
+		"region": "de-de",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("language"); got != "de-DE" {
+		t.Errorf("Expected language derived from region, got %q", got)
+	}
+	if response.Region != "de-de" {
+		t.Errorf("Expected effective region to be recorded, got %q", response.Region)
+	}
+
+	// An explicit language wins over the region
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":    "golang",
+		"region":   "de-de",
+		"language": "fr",
+	}); err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if got := received.URL.Query().Get("language"); got != "fr" {
+		t.Errorf("Expected explicit language to take precedence, got %q", got)
+	}
+}
+
+func TestSearXNGProvider_SafeSearch(t *testing.T) {
+	tests := []struct {
+		safeSearch string
+		expected   string
+	}{
+		{safeSearch: "", expected: "1"},
+		{safeSearch: "off", expected: "0"},
+		{safeSearch: "moderate", expected: "1"},
+		{safeSearch: "strict", expected: "2"},
+		{safeSearch: "2", expected: "2"}, // numeric levels remain accepted
+	}
+
+	for _, tt := range tests {
+		var received *http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(searxngJSONFixture))
+		}))
+
+		args := map[string]any{"query": "golang"}
+		if tt.safeSearch != "" {
+			args["safesearch"] = tt.safeSearch
+		}
+		provider := &SearXNGProvider{baseURL: server.URL, client: server.Client()}
+		_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", args)
+		server.Close()
+		if err != nil {
+			t.Fatalf("safesearch %q: expected success, got error: %v", tt.safeSearch, err)
+		}
+		if got := received.URL.Query().Get("safesearch"); got != tt.expected {
+			t.Errorf("safesearch %q: expected %s, got %q", tt.safeSearch, tt.expected, got)
+		}
+	}
+}
+
+func TestSearXNGProvider_TimeRangeWithPaging(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(searxngJSONFixture))
+	}))
+	defer server.Close()
+
+	provider := &SearXNGProvider{baseURL: server.URL, client: server.Client()}
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":      "golang",
+		"pageno":     float64(3),
+		"region":     "fr-fr",
+		"time_range": "week",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	query := received.URL.Query()
+	if query.Get("time_range") != "week" || query.Get("pageno") != "3" || query.Get("language") != "fr-FR" {
+		t.Errorf("Unexpected query parameters: %v", query)
+	}
+	if response.TimeRange != "week" {
+		t.Errorf("Expected applied time range to be echoed, got %q", response.TimeRange)
+	}
+}
@@ -0,0 +1,181 @@
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// TavilyAPIBaseURL is the base URL for the Tavily API
+	TavilyAPIBaseURL = "https://api.tavily.com"
+
+	// UserAgent for API requests
+	UserAgent = "mcp-devtools/1.0"
+
+	// Tavily specific status codes for exhausted credits
+	statusPlanLimitExceeded       = 432
+	statusPayAsYouGoLimitExceeded = 433
+
+	// maxBodyPreview caps how much of an unparseable response body is included in the error
+	maxBodyPreview = 200
+)
+
+// TavilyClient handles HTTP requests to the Tavily API
+type TavilyClient struct {
+	apiKey     string
+	httpClient internetsearch.HTTPClientInterface
+	baseURL    string
+}
+
+// NewTavilyClient creates a new Tavily API client with rate limiting
+func NewTavilyClient(apiKey string) *TavilyClient {
+	return &TavilyClient{
+		apiKey:     apiKey,
+		baseURL:    TavilyAPIBaseURL,
+		httpClient: internetsearch.NewRateLimitedHTTPClient(),
+	}
+}
+
+// Search performs a search using the Tavily API
+func (c *TavilyClient) Search(ctx context.Context, logger *logrus.Logger, request TavilySearchRequest) (*TavilySearchResponse, error) {
+	reqURL, err := url.Parse(c.baseURL + "/search")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Check domain access security for API endpoint using security helper
+	if err := security.CheckDomainAccess(reqURL.Hostname()); err != nil {
+		if secErr, ok := err.(*security.SecurityError); ok {
+			return nil, security.FormatSecurityBlockError(secErr)
+		}
+		return nil, err
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", reqURL.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("User-Agent", UserAgent)
+
+	logger.WithFields(logrus.Fields{
+		"url":          reqURL.String(),
+		"search_depth": request.SearchDepth,
+		"topic":        request.Topic,
+	}).Debug("Making Tavily API request")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request canceled: %w", ctx.Err())
+		}
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: search request failed: %w", internetsearch.ErrNetwork, err))
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("Failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, internetsearch.Retryable(fmt.Errorf("%w: failed to read response body: %w", internetsearch.ErrNetwork, err))
+	}
+	internetsearch.CaptureResponse(ctx, logger, "tavily", resp, body)
+
+	// Security analysis on content
+	if security.IsEnabled() {
+		sourceCtx := security.SourceContext{
+			URL:         reqURL.String(),
+			Domain:      reqURL.Hostname(),
+			ContentType: resp.Header.Get("Content-Type"),
+			Tool:        "internetsearch",
+		}
+
+		if secResult, err := security.AnalyseContent(string(body), sourceCtx); err == nil {
+			switch secResult.Action {
+			case security.ActionBlock:
+				return nil, security.FormatSecurityBlockErrorFromResult(secResult)
+			case security.ActionWarn:
+				logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
+			}
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.translateError(logger, resp, body)
+	}
+
+	var response TavilySearchResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse search response (status %d): %w, body: %s", internetsearch.ErrParse, resp.StatusCode, err, bodyPreview(body))
+	}
+
+	return &response, nil
+}
+
+// bodyPreview returns the start of a response body for error messages, e.g. an HTML page from a proxy
+func bodyPreview(body []byte) string {
+	preview := strings.TrimSpace(string(body))
+	if len(preview) <= maxBodyPreview {
+		return preview
+	}
+	preview = preview[:maxBodyPreview]
+	// Avoid cutting a multi-byte character in half
+	for !utf8.ValidString(preview) {
+		preview = preview[:len(preview)-1]
+	}
+	return preview + "..."
+}
+
+// translateError converts a Tavily error response into a descriptive error
+func (c *TavilyClient) translateError(logger *logrus.Logger, resp *http.Response, body []byte) error {
+	logger.WithFields(logrus.Fields{
+		"status_code": resp.StatusCode,
+		"status":      resp.Status,
+	}).Error("Tavily API request failed")
+
+	detail := ""
+	var errorResp TavilyErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		detail = errorResp.Detail.Error
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%w: invalid Tavily API key", internetsearch.ErrAuth)
+	case http.StatusTooManyRequests:
+		return &internetsearch.RateLimitError{
+			Provider:   "tavily",
+			RetryAfter: internetsearch.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	case statusPlanLimitExceeded:
+		return fmt.Errorf("%w: tavily plan usage limit exceeded, upgrade your plan or wait for the monthly reset", internetsearch.ErrQuotaExceeded)
+	case statusPayAsYouGoLimitExceeded:
+		return fmt.Errorf("%w: tavily pay-as-you-go limit exceeded, increase the limit in your Tavily account settings", internetsearch.ErrQuotaExceeded)
+	}
+
+	if detail != "" {
+		return fmt.Errorf("tavily API error (%d): %s", resp.StatusCode, detail)
+	}
+	return fmt.Errorf("tavily API request failed with status %d: %s", resp.StatusCode, string(body))
+}
@@ -0,0 +1,202 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Tavily API result limits
+	tavilyMinResults = 1
+	tavilyMaxResults = 20
+)
+
+// TavilyProvider implements the unified SearchProvider interface
+type TavilyProvider struct {
+	client *TavilyClient
+}
+
+// NewTavilyProvider creates a new Tavily search provider
+func NewTavilyProvider() *TavilyProvider {
+	apiKey := strings.TrimSpace(os.Getenv("TAVILY_API_KEY"))
+	if apiKey == "" {
+		return nil
+	}
+
+	return &TavilyProvider{
+		client: NewTavilyClient(apiKey),
+	}
+}
+
+// GetName returns the provider name
+func (p *TavilyProvider) GetName() string {
+	return "tavily"
+}
+
+// IsAvailable checks if the provider is available
+func (p *TavilyProvider) IsAvailable() bool {
+	return p.client != nil && strings.TrimSpace(os.Getenv("TAVILY_API_KEY")) != ""
+}
+
+// GetSupportedTypes returns the search types this provider supports
+func (p *TavilyProvider) GetSupportedTypes() []string {
+	return []string{"web", "news"}
+}
+
+// Describe returns how the provider is configured, for provider listings
+func (p *TavilyProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{
+		Summary:         "Tavily Search API with web and news search and synthesised answers",
+		RequiredEnvVars: []string{"TAVILY_API_KEY"},
+	}
+}
+
+// Search executes a search using the Tavily provider
+func (p *TavilyProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	logger.WithFields(logrus.Fields{
+		"provider": "tavily",
+		"type":     searchType,
+		"query":    query,
+	}).Debug("Tavily search parameters")
+
+	timeRange, err := internetsearch.ParseTimeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *internetsearch.SearchResponse
+	switch searchType {
+	case "web":
+		response, err = p.executeSearch(ctx, logger, "general", args, timeRange)
+	case "news":
+		response, err = p.executeSearch(ctx, logger, "news", args, timeRange)
+	default:
+		return nil, fmt.Errorf("unsupported search type for Tavily: %s", searchType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response.ApplyTimeRange(timeRange, true)
+	return response, nil
+}
+
+// executeSearch handles search execution for the given Tavily topic
+func (p *TavilyProvider) executeSearch(ctx context.Context, logger *logrus.Logger, topic string, args map[string]any, timeRange string) (*internetsearch.SearchResponse, error) {
+	query := args["query"].(string)
+
+	// Parse optional parameters
+	maxResults := 5
+	if countRaw, ok := args["count"].(float64); ok {
+		maxResults = int(countRaw)
+		if maxResults < tavilyMinResults || maxResults > tavilyMaxResults {
+			return nil, fmt.Errorf("count must be between %d and %d for Tavily search, got %d", tavilyMinResults, tavilyMaxResults, maxResults)
+		}
+	}
+
+	searchDepth := "basic"
+	if depthRaw, ok := args["search_depth"].(string); ok && depthRaw != "" {
+		if depthRaw != "basic" && depthRaw != "advanced" {
+			return nil, fmt.Errorf("search_depth must be 'basic' or 'advanced' for Tavily search, got %q", depthRaw)
+		}
+		searchDepth = depthRaw
+	}
+
+	includeAnswer := true
+	if includeAnswerRaw, ok := args["include_answer"].(bool); ok {
+		includeAnswer = includeAnswerRaw
+	}
+
+	domains, err := internetsearch.ParseDomainFilter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	request := TavilySearchRequest{
+		Query:          query,
+		Topic:          topic,
+		SearchDepth:    searchDepth,
+		IncludeAnswer:  includeAnswer,
+		MaxResults:     maxResults,
+		TimeRange:      timeRange,
+		IncludeDomains: domains.Include,
+		ExcludeDomains: domains.Exclude,
+	}
+
+	response, err := p.client.Search(ctx, logger, request)
+	if err != nil {
+		return nil, fmt.Errorf("tavily search failed: %w", err)
+	}
+
+	// Convert to unified format
+	if response.Answer == "" && len(response.Results) == 0 {
+		return p.createEmptyResponse()
+	}
+
+	results := make([]internetsearch.SearchResult, 0, len(response.Results)+1)
+
+	// Tavily's synthesised answer is returned as a synthetic first result
+	if response.Answer != "" {
+		results = append(results, internetsearch.SearchResult{
+			Title:       fmt.Sprintf("Answer: %s", query),
+			Description: response.Answer,
+			Type:        "answer",
+			Metadata: map[string]any{
+				"provider":     "tavily",
+				"search_depth": searchDepth,
+			},
+		})
+	}
+
+	for i, tavilyResult := range response.Results {
+		metadata := make(map[string]any)
+		metadata["score"] = tavilyResult.Score
+		metadata["position"] = i + 1
+		if tavilyResult.PublishedDate != "" {
+			metadata["published"] = tavilyResult.PublishedDate
+		}
+
+		results = append(results, internetsearch.SearchResult{
+			Title:       tavilyResult.Title,
+			URL:         tavilyResult.URL,
+			Description: tavilyResult.Content,
+			Metadata:    metadata,
+		})
+	}
+
+	return p.createSuccessResponse(query, results, logger)
+}
+
+// Helper functions
+func (p *TavilyProvider) createEmptyResponse() (*internetsearch.SearchResponse, error) {
+	result := &internetsearch.SearchResponse{
+		Results:   []internetsearch.SearchResult{},
+		Provider:  "tavily",
+		Timestamp: time.Now(),
+	}
+	return result, nil
+}
+
+func (p *TavilyProvider) createSuccessResponse(query string, results []internetsearch.SearchResult, logger *logrus.Logger) (*internetsearch.SearchResponse, error) {
+	result := &internetsearch.SearchResponse{
+		Results:   results,
+		Provider:  "tavily",
+		Timestamp: time.Now(),
+	}
+
+	logger.WithFields(logrus.Fields{
+		"query":        query,
+		"result_count": len(results),
+		"provider":     "tavily",
+	}).Info("Tavily search completed successfully")
+
+	return result, nil
+}
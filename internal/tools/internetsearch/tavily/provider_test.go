@@ -0,0 +1,241 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const tavilySearchFixture = `{
+  "query": "what is golang",
+  "answer": "Go is a statically typed, compiled programming language designed at Google.",
+  "results": [
+    {
+      "title": "The Go Programming Language",
+      "url": "https://go.dev/",
+      "content": "Go is an open source programming language that makes it simple to build secure, scalable systems.",
+      "score": 0.98
+    },
+    {
+      "title": "Go (programming language) - Wikipedia",
+      "url": "https://en.wikipedia.org/wiki/Go_(programming_language)",
+      "content": "Go is a statically typed, compiled high-level programming language.",
+      "score": 0.91
+    }
+  ],
+  "response_time": 1.2
+}`
+
+func newTestProvider(server *httptest.Server) *TavilyProvider {
+	return &TavilyProvider{
+		client: &TavilyClient{
+			apiKey:     "tvly-test",
+			baseURL:    server.URL,
+			httpClient: server.Client(),
+		},
+	}
+}
+
+func TestTavilyProvider_AnswerAndResults(t *testing.T) {
+	var received TavilySearchRequest
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(tavilySearchFixture))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":           "what is golang",
+		"count":           float64(2),
+		"search_depth":    "advanced",
+		"include_domains": []any{"go.dev", "wikipedia.org"},
+		"exclude_domains": []any{"example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+
+	if authHeader != "Bearer tvly-test" {
+		t.Errorf("Expected bearer token authorisation, got %q", authHeader)
+	}
+	if received.SearchDepth != "advanced" || received.MaxResults != 2 || !received.IncludeAnswer || received.Topic != "general" {
+		t.Errorf("Unexpected request body: %+v", received)
+	}
+	if len(received.IncludeDomains) != 2 || len(received.ExcludeDomains) != 1 {
+		t.Errorf("Expected domain filters to be sent, got include=%v exclude=%v", received.IncludeDomains, received.ExcludeDomains)
+	}
+
+	if len(response.Results) != 3 {
+		t.Fatalf("Expected answer plus 2 results, got %d", len(response.Results))
+	}
+	if response.Results[0].Type != "answer" || !strings.Contains(response.Results[0].Description, "statically typed") {
+		t.Errorf("Expected synthetic answer as first result, got %+v", response.Results[0])
+	}
+	if response.Results[1].Type != "" || response.Results[1].Metadata["score"] != 0.98 {
+		t.Errorf("Expected web result with score metadata, got %+v", response.Results[1])
+	}
+}
+
+func TestTavilyProvider_NewsTopic(t *testing.T) {
+	var received TavilySearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"query": "go", "results": []}`))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	response, err := provider.Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{"query": "go"})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.Topic != "news" {
+		t.Errorf("Expected news topic, got %q", received.Topic)
+	}
+	if len(response.Results) != 0 {
+		t.Errorf("Expected no results, got %d", len(response.Results))
+	}
+}
+
+func TestTavilyProvider_QuotaErrors(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected string
+	}{
+		{status: 432, expected: "plan usage limit exceeded"},
+		{status: 433, expected: "pay-as-you-go limit exceeded"},
+		{status: http.StatusUnauthorized, expected: "invalid Tavily API key"},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			_, _ = w.Write([]byte(`{"detail": {"error": "limit reached"}}`))
+		}))
+
+		provider := newTestProvider(server)
+		_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go"})
+		server.Close()
+
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("Status %d: expected error containing %q, got %v", tt.status, tt.expected, err)
+		}
+	}
+}
+
+func TestTavilyProvider_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go"})
+
+	var rateLimitErr *internetsearch.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got %v", err)
+	}
+}
+
+func TestTavilyProvider_UnparseableResponse(t *testing.T) {
+	page := "<html><head><title>502 Bad Gateway</title></head><body>" + strings.Repeat("<p>upstream unavailable</p>", 20) + "</body></html>"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	_, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go"})
+	if !errors.Is(err, internetsearch.ErrParse) {
+		t.Fatalf("Expected ErrParse, got %v", err)
+	}
+	message := err.Error()
+	if !strings.Contains(message, "status 200") || !strings.Contains(message, "502 Bad Gateway") {
+		t.Errorf("Expected the status and a body preview, got %v", err)
+	}
+	if strings.Contains(message, "</html>") || !strings.HasSuffix(message, "...") {
+		t.Errorf("Expected the body preview to be truncated, got %v", err)
+	}
+}
+
+func TestTavilyProvider_InvalidParameters(t *testing.T) {
+	provider := &TavilyProvider{client: NewTavilyClient("tvly-test")}
+
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go", "count": float64(21)}); err == nil {
+		t.Error("Expected error for count > 20")
+	}
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{"query": "go", "search_depth": "deep"}); err == nil {
+		t.Error("Expected error for invalid search_depth")
+	}
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "image", map[string]any{"query": "go"}); err == nil {
+		t.Error("Expected error for unsupported search type")
+	}
+}
+
+func TestTavilyProvider_TimeRange(t *testing.T) {
+	var received TavilySearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(tavilySearchFixture))
+	}))
+	defer server.Close()
+
+	response, err := newTestProvider(server).Search(context.Background(), testutils.DiscardLogger(), "news", map[string]any{
+		"query":      "golang",
+		"time_range": "day",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if received.TimeRange != "day" {
+		t.Errorf("Expected time_range to be sent, got %q", received.TimeRange)
+	}
+	if response.TimeRange != "day" {
+		t.Errorf("Expected applied time range to be echoed, got %q", response.TimeRange)
+	}
+}
+
+func TestTavilyProvider_DomainFilters(t *testing.T) {
+	var received TavilySearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(tavilySearchFixture))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server)
+	_, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":           "golang",
+		"include_domains": []any{"https://www.Go.dev/doc", "go.dev", "*.github.com"},
+		"exclude_domains": "Pinterest.com",
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if !slices.Equal(received.IncludeDomains, []string{"go.dev", "github.com"}) || !slices.Equal(received.ExcludeDomains, []string{"pinterest.com"}) {
+		t.Errorf("Expected normalised domains, got include=%v exclude=%v", received.IncludeDomains, received.ExcludeDomains)
+	}
+
+	if _, err := provider.Search(context.Background(), testutils.DiscardLogger(), "web", map[string]any{
+		"query":           "golang",
+		"include_domains": []any{"localhost"},
+	}); err == nil {
+		t.Error("Expected error for invalid domain")
+	}
+}
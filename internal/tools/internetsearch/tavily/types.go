@@ -0,0 +1,37 @@
+package tavily
+
+// TavilySearchRequest represents the request body for the Tavily search API
+type TavilySearchRequest struct {
+	Query          string   `json:"query"`
+	Topic          string   `json:"topic,omitempty"`
+	SearchDepth    string   `json:"search_depth,omitempty"`
+	IncludeAnswer  bool     `json:"include_answer"`
+	MaxResults     int      `json:"max_results,omitempty"`
+	TimeRange      string   `json:"time_range,omitempty"`
+	IncludeDomains []string `json:"include_domains,omitempty"`
+	ExcludeDomains []string `json:"exclude_domains,omitempty"`
+}
+
+// TavilySearchResponse represents the response from the Tavily search API
+type TavilySearchResponse struct {
+	Query        string         `json:"query"`
+	Answer       string         `json:"answer,omitempty"`
+	Results      []TavilyResult `json:"results"`
+	ResponseTime float64        `json:"response_time,omitempty"`
+}
+
+// TavilyResult represents a single search result from Tavily
+type TavilyResult struct {
+	Title         string  `json:"title"`
+	URL           string  `json:"url"`
+	Content       string  `json:"content"`
+	Score         float64 `json:"score"`
+	PublishedDate string  `json:"published_date,omitempty"`
+}
+
+// TavilyErrorResponse represents an error response from the Tavily API
+type TavilyErrorResponse struct {
+	Detail struct {
+		Error string `json:"error"`
+	} `json:"detail"`
+}
@@ -1,6 +1,7 @@
 package internetsearch
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -9,12 +10,46 @@ type SearchResult struct {
 	Title       string         `json:"title"`
 	URL         string         `json:"url"`
 	Description string         `json:"description"`
+	Type        string         `json:"type,omitempty"` // Result kind when not a regular result, e.g. "answer"
 	Metadata    map[string]any `json:"metadata,omitempty"`
 }
 
+// ProviderDescription describes how a provider is configured, for listing providers to agents
+type ProviderDescription struct {
+	Summary         string   `json:"summary"`
+	RequiredEnvVars []string `json:"required_env_vars,omitempty"` // All must be set for the provider to be registered
+	OptionalEnvVars []string `json:"optional_env_vars,omitempty"` // Tune the provider but don't affect availability
+}
+
 // SearchResponse represents a unified response structure
 type SearchResponse struct {
-	Results   []SearchResult `json:"results"`
-	Provider  string         `json:"provider"`
-	Timestamp time.Time      `json:"timestamp"`
+	Results           []SearchResult `json:"results"`
+	Provider          string         `json:"provider"`
+	Timestamp         time.Time      `json:"timestamp"`
+	Region            string         `json:"region,omitempty"`             // Effective region when the provider applied one
+	TimeRange         string         `json:"time_range,omitempty"`         // Applied time range (day/week/month/year)
+	Cached            bool           `json:"cached,omitempty"`             // Served from the search cache, Timestamp is when it was fetched
+	DuplicatesRemoved int            `json:"duplicates_removed,omitempty"` // Results dropped as duplicates of a higher ranked URL
+	Metadata          map[string]any `json:"metadata,omitempty"`
+}
+
+// SetMetadata sets a response level metadata value, creating the map if needed
+func (r *SearchResponse) SetMetadata(key string, value any) {
+	if r.Metadata == nil {
+		r.Metadata = make(map[string]any)
+	}
+	r.Metadata[key] = value
+}
+
+// ApplyTimeRange echoes a time range the provider applied, or records in Metadata that it
+// could not be honoured so callers know the results are not filtered
+func (r *SearchResponse) ApplyTimeRange(timeRange string, applied bool) {
+	if timeRange == "" {
+		return
+	}
+	if applied {
+		r.TimeRange = timeRange
+		return
+	}
+	r.SetMetadata("time_range_unsupported", fmt.Sprintf("%s cannot filter this search by time range, results are not limited to the past %s", r.Provider, timeRange))
 }
@@ -0,0 +1,138 @@
+package unified
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultSearchCacheTTL is how long search responses are cached by default
+	DefaultSearchCacheTTL = 10 * time.Minute
+	// SearchCacheTTLEnvVar is the environment variable for configuring the cache TTL, "0" disables caching
+	SearchCacheTTLEnvVar = "SEARCH_CACHE_TTL"
+
+	// searchCacheKeyPrefix namespaces search entries in the shared tool cache
+	searchCacheKeyPrefix = "internet_search:"
+	// searchCacheSweepInterval is the minimum time between sweeps for expired entries
+	searchCacheSweepInterval = time.Minute
+)
+
+// searchCacheIgnoredArgs are arguments that don't change the results and so aren't part of the cache key
+var searchCacheIgnoredArgs = []string{"query", "type", "provider", "no_cache", "timeout_seconds", "debug"}
+
+// SearchCacheEntry represents a cached search response
+type SearchCacheEntry struct {
+	Response  *internetsearch.SearchResponse
+	ExpiresAt time.Time
+}
+
+// searchCache stores search responses in the shared tool cache and periodically sweeps expired entries
+type searchCache struct {
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// getSearchCacheTTL returns the configured cache TTL, accepting a duration ("15m") or a number of seconds
+func getSearchCacheTTL() time.Duration {
+	envValue := strings.TrimSpace(os.Getenv(SearchCacheTTLEnvVar))
+	if envValue == "" {
+		return DefaultSearchCacheTTL
+	}
+	if seconds, err := strconv.Atoi(envValue); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if ttl, err := time.ParseDuration(envValue); err == nil && ttl >= 0 {
+		return ttl
+	}
+	return DefaultSearchCacheTTL
+}
+
+// searchCacheKey builds a cache key from the provider, search type, normalised query and remaining arguments
+func searchCacheKey(providerName, searchType, query string, args map[string]any) string {
+	keys := make([]string, 0, len(args))
+	for key := range args {
+		if !slices.Contains(searchCacheIgnoredArgs, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, fmt.Sprintf("%s=%v", key, args[key]))
+	}
+
+	normalisedQuery := strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	return fmt.Sprintf("%s%s:%s:%s:%s", searchCacheKeyPrefix, providerName, searchType, normalisedQuery, strings.Join(params, "&"))
+}
+
+// load returns a copy of a cached response marked as cached, deleting the entry if it has expired
+func (c *searchCache) load(cache *sync.Map, key string) (*internetsearch.SearchResponse, bool) {
+	raw, ok := cache.Load(key)
+	if !ok {
+		return nil, false
+	}
+
+	entry, ok := raw.(SearchCacheEntry)
+	if !ok || !time.Now().Before(entry.ExpiresAt) {
+		cache.Delete(key)
+		return nil, false
+	}
+
+	// Keep the original timestamp so consumers can tell how old the results are
+	response := *entry.Response
+	response.Cached = true
+	return &response, true
+}
+
+// store caches a response for the TTL and sweeps expired entries if a sweep is due
+func (c *searchCache) store(cache *sync.Map, logger *logrus.Logger, key string, response *internetsearch.SearchResponse, ttl time.Duration) {
+	cache.Store(key, SearchCacheEntry{
+		Response:  response,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	logger.WithFields(logrus.Fields{
+		"cache_key": key,
+		"ttl":       ttl,
+	}).Debug("Cached search response")
+
+	c.sweep(cache, logger)
+}
+
+// sweep removes expired search entries, at most once per sweep interval, so entries that are never
+// read again don't accumulate
+func (c *searchCache) sweep(cache *sync.Map, logger *logrus.Logger) {
+	c.mu.Lock()
+	now := time.Now()
+	if now.Sub(c.lastSweep) < searchCacheSweepInterval {
+		c.mu.Unlock()
+		return
+	}
+	c.lastSweep = now
+	c.mu.Unlock()
+
+	removed := 0
+	cache.Range(func(key, value any) bool {
+		keyStr, ok := key.(string)
+		if !ok || !strings.HasPrefix(keyStr, searchCacheKeyPrefix) {
+			return true
+		}
+		if entry, ok := value.(SearchCacheEntry); !ok || !now.Before(entry.ExpiresAt) {
+			cache.Delete(key)
+			removed++
+		}
+		return true
+	})
+
+	if removed > 0 {
+		logger.WithField("removed", removed).Debug("Swept expired search cache entries")
+	}
+}
@@ -0,0 +1,282 @@
+package unified
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/sammcj/mcp-devtools/internal/security"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/internal/utils/httpclient"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultFetchContentTimeout bounds the total time spent fetching page content for a search
+	DefaultFetchContentTimeout = 10 * time.Second
+	// FetchContentTimeoutEnvVar is the environment variable for configuring the content fetch deadline
+	FetchContentTimeoutEnvVar = "SEARCH_FETCH_CONTENT_TIMEOUT"
+
+	// maxFetchContent is the most results whose pages can be fetched for one search
+	maxFetchContent = 10
+	// contentFetchConcurrency is how many pages are fetched at once
+	contentFetchConcurrency = 4
+	// contentFetchPageTimeout bounds each page fetch, including its robots.txt check
+	contentFetchPageTimeout = 5 * time.Second
+	// contentFetchMaxBody is the most of a page that is read, as the extract only needs the start
+	contentFetchMaxBody = 2 * 1024 * 1024
+	// contentExtractLength is the number of characters of page text attached to each result
+	contentExtractLength = 2000
+
+	// contentFetchAgentToken identifies the fetcher in robots.txt user-agent groups
+	contentFetchAgentToken = "mcp-devtools"
+	// contentFetchUserAgent is sent with page and robots.txt requests
+	contentFetchUserAgent = "mcp-devtools-search/1.0 (AI Assistant Tool)"
+)
+
+// contentSkipSelector matches elements that don't hold a page's readable text
+const contentSkipSelector = "script, style, noscript, template, svg, iframe, nav, header, footer, aside, form, [role=navigation], [aria-hidden=true]"
+
+// contentBlockSelector matches block elements whose text would otherwise run into the next element's
+const contentBlockSelector = "p, div, section, article, h1, h2, h3, h4, h5, h6, li, dt, dd, br, tr, td, th, blockquote, pre, figcaption"
+
+// contentFetcher fetches result pages and extracts their readable text
+type contentFetcher struct {
+	client internetsearch.HTTPClientInterface
+	robots robotsCache
+}
+
+// newContentFetcher creates a content fetcher using the shared proxy-aware HTTP client
+func newContentFetcher() *contentFetcher {
+	client := httpclient.NewHTTPClientWithProxy(contentFetchPageTimeout)
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return fmt.Errorf("too many redirects")
+		}
+		if err := security.CheckDomainAccess(req.URL.Hostname()); err != nil {
+			return err
+		}
+		req.Header.Set("User-Agent", contentFetchUserAgent)
+		return nil
+	}
+	return &contentFetcher{client: client}
+}
+
+// getFetchContentTimeout returns the configured content fetch deadline, accepting a duration ("5s") or a number of seconds
+func getFetchContentTimeout() time.Duration {
+	envValue := strings.TrimSpace(os.Getenv(FetchContentTimeoutEnvVar))
+	if envValue == "" {
+		return DefaultFetchContentTimeout
+	}
+	if seconds, err := strconv.Atoi(envValue); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if timeout, err := time.ParseDuration(envValue); err == nil && timeout > 0 {
+		return timeout
+	}
+	return DefaultFetchContentTimeout
+}
+
+// parseFetchContent reads the optional fetch_content argument, the number of top results whose pages are fetched
+func parseFetchContent(args map[string]any) (int, error) {
+	raw, ok := args["fetch_content"]
+	if !ok || raw == nil {
+		return 0, nil
+	}
+	count, ok := raw.(float64)
+	if !ok || count != float64(int(count)) || count < 0 || count > maxFetchContent {
+		return 0, fmt.Errorf("invalid fetch_content %v, must be a whole number between 0 and %d", raw, maxFetchContent)
+	}
+	return int(count), nil
+}
+
+// fetchContent fetches the pages of the top results concurrently and attaches the start of their text
+// to each result's "content" metadata. Pages that can't be fetched are marked with "fetch_error" rather
+// than failing the search, and all fetches are abandoned once the deadline passes.
+func (f *contentFetcher) fetchContent(ctx context.Context, logger *logrus.Logger, response *internetsearch.SearchResponse, count int, deadline time.Duration) {
+	if response == nil || count <= 0 {
+		return
+	}
+	count = min(count, len(response.Results))
+
+	ctx, cancel := context.WithTimeout(ctx, deadline)
+	defer cancel()
+
+	pages := make([]pageContent, count)
+	errs := make([]error, count)
+	semaphore := make(chan struct{}, contentFetchConcurrency)
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			pages[i], errs[i] = f.fetchPage(ctx, response.Results[i].URL)
+		}(i)
+	}
+	wg.Wait()
+
+	fetched := 0
+	for i := range count {
+		result := &response.Results[i]
+		if result.Metadata == nil {
+			result.Metadata = make(map[string]any)
+		}
+		if err := errs[i]; err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				if ctx.Err() != nil {
+					err = fmt.Errorf("content fetch deadline of %s exceeded", deadline)
+				} else {
+					err = fmt.Errorf("timed out after %s", contentFetchPageTimeout)
+				}
+			}
+			result.Metadata["fetch_error"] = err.Error()
+			logger.WithFields(logrus.Fields{
+				"url":   result.URL,
+				"error": err,
+			}).Debug("Failed to fetch result content")
+			continue
+		}
+		result.Metadata["content"] = pages[i].text
+		if pages[i].securityWarning != "" {
+			result.Metadata["content_security_warning"] = pages[i].securityWarning
+		}
+		fetched++
+	}
+	response.SetMetadata("content_fetched", fetched)
+}
+
+// pageContent is the text extracted from a fetched page
+type pageContent struct {
+	text            string
+	securityWarning string
+}
+
+// fetchPage fetches a single result page, checking robots.txt first, and returns the start of its readable text
+func (f *contentFetcher) fetchPage(ctx context.Context, rawURL string) (pageContent, error) {
+	ctx, cancel := context.WithTimeout(ctx, contentFetchPageTimeout)
+	defer cancel()
+
+	target, err := url.Parse(rawURL)
+	if err != nil || target.Host == "" {
+		return pageContent{}, fmt.Errorf("invalid URL")
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return pageContent{}, fmt.Errorf("unsupported URL scheme: %s", target.Scheme)
+	}
+	if err := security.CheckDomainAccess(target.Hostname()); err != nil {
+		return pageContent{}, err
+	}
+
+	allowed, err := f.robots.allowed(ctx, f.client, target)
+	if err != nil {
+		return pageContent{}, err
+	}
+	if !allowed {
+		return pageContent{}, fmt.Errorf("disallowed by robots.txt")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return pageContent{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", contentFetchUserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.1")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return pageContent{}, ctxErr
+		}
+		return pageContent{}, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return pageContent{}, fmt.Errorf("HTTP error %d", resp.StatusCode)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		if mediaType == "" {
+			mediaType = "unknown"
+		}
+		return pageContent{}, fmt.Errorf("skipped non-HTML content (%s)", mediaType)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(io.LimitReader(resp.Body, contentFetchMaxBody))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return pageContent{}, ctxErr
+		}
+		return pageContent{}, fmt.Errorf("failed to parse page: %w", err)
+	}
+
+	content := extractReadableText(doc)
+	if content == "" {
+		return pageContent{}, fmt.Errorf("no readable text found")
+	}
+
+	source := security.SourceContext{
+		URL:         rawURL,
+		Domain:      target.Hostname(),
+		ContentType: "web_content",
+		Tool:        "internet_search",
+	}
+	page := pageContent{text: content}
+	if secResult, err := security.AnalyseContent(content, source); err == nil {
+		switch secResult.Action {
+		case security.ActionBlock:
+			return pageContent{}, fmt.Errorf("content blocked by security policy: %s", secResult.Message)
+		case security.ActionWarn:
+			page.securityWarning = secResult.Message
+		}
+	}
+	return page, nil
+}
+
+// extractReadableText returns the start of a page's main text, preferring the main or article element
+// and dropping scripts, navigation and other boilerplate
+func extractReadableText(doc *goquery.Document) string {
+	doc.Find(contentSkipSelector).Remove()
+	doc.Find(contentBlockSelector).AfterHtml(" ")
+
+	root := doc.Find("main, article, [role=main]").First()
+	if root.Length() == 0 {
+		root = doc.Find("body")
+	}
+
+	text := strings.Join(strings.Fields(root.Text()), " ")
+	return truncateText(text, contentExtractLength)
+}
+
+// truncateText shortens text to at most limit characters, breaking at a word boundary where possible
+func truncateText(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	runes := []rune(text)[:limit]
+	truncated := string(runes)
+	if index := strings.LastIndex(truncated, " "); index > len(truncated)/2 {
+		truncated = truncated[:index]
+	}
+	return truncated + "…"
+}
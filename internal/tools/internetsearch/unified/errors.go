@@ -0,0 +1,80 @@
+package unified
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+)
+
+// providerErrorHint returns guidance for a classified provider error, or "" when the error isn't classified
+func providerErrorHint(err error) string {
+	var rateLimitErr *internetsearch.RateLimitError
+	var timeoutErr *internetsearch.TimeoutError
+	switch {
+	case errors.As(err, &timeoutErr):
+		return "raise timeout_seconds or INTERNET_SEARCH_TIMEOUT for slow connections, or use another provider"
+	case errors.As(err, &rateLimitErr):
+		if rateLimitErr.RetryAfter > 0 {
+			return fmt.Sprintf("rate limited, retry after %s or use another provider", rateLimitErr.RetryAfter)
+		}
+		return "rate limited, wait before retrying or use another provider"
+	case errors.Is(err, internetsearch.ErrAuth):
+		return "check the provider's API key is set correctly and its plan includes this search type"
+	case errors.Is(err, internetsearch.ErrQuotaExceeded):
+		return "the provider's usage quota is used up, wait for it to reset or use another provider"
+	case errors.Is(err, internetsearch.ErrProviderBlocked):
+		return "the provider is refusing automated requests, use another provider for now"
+	case errors.Is(err, internetsearch.ErrParse):
+		return "the provider returned a response in an unexpected format, its API or page layout may have changed"
+	case errors.Is(err, internetsearch.ErrNetwork):
+		return "the provider couldn't be reached, check network connectivity and proxy settings"
+	default:
+		return ""
+	}
+}
+
+// withErrorHint appends the guidance for a classified error, keeping the original error wrapped
+func withErrorHint(err error) error {
+	if hint := providerErrorHint(err); hint != "" {
+		return fmt.Errorf("%w (%s)", err, hint)
+	}
+	return err
+}
+
+// providerFailure names the provider an error came from, adding guidance for classified errors
+func providerFailure(providerName string, err error) error {
+	return fmt.Errorf("%s: %w", providerName, withErrorHint(err))
+}
+
+// allProvidersFailedError reports that every provider tried failed, wrapping each provider's error
+// so the failures can still be matched with errors.Is and errors.As
+type allProvidersFailedError struct {
+	errs []error
+}
+
+// Error implements the error interface
+func (e *allProvidersFailedError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+	return "all providers failed: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the errors from each provider
+func (e *allProvidersFailedError) Unwrap() []error {
+	return e.errs
+}
+
+// fallbackDelay returns how long to wait before the given fallback attempt. Failures that won't change
+// by waiting (a bad API key, an exhausted quota or an unparseable response) move straight on, while
+// rate limits, blocks and network errors back off by attempt to avoid rapid-fire requests.
+func fallbackDelay(attempt int, previous error) time.Duration {
+	if errors.Is(previous, internetsearch.ErrAuth) || errors.Is(previous, internetsearch.ErrQuotaExceeded) || errors.Is(previous, internetsearch.ErrParse) {
+		return 0
+	}
+	return time.Duration(attempt) * fallbackBaseDelay
+}
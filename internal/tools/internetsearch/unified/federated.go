@@ -0,0 +1,247 @@
+package unified
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// allProviders is the provider value that fans a search out to every available provider
+	allProviders = "all"
+
+	// federatedCacheProvider is the provider name federated searches are cached under
+	federatedCacheProvider = "federated"
+)
+
+// Merge strategies for federated searches
+const (
+	MergeInterleave = "interleave" // Alternate between providers by rank
+	MergeGrouped    = "grouped"    // Keep each provider's results together, in provider priority order
+)
+
+// federatedProviders returns the providers a federated search should fan out to, in priority order,
+// along with notes for requested providers that can't be used. ok is false for a regular search.
+func (t *InternetSearchTool) federatedProviders(searchType string, args map[string]any) (names []string, skipped map[string]string, ok bool) {
+	requested := internetsearch.StringSliceArg(args, "providers")
+	provider, _ := args["provider"].(string)
+
+	if provider == allProviders || slices.Contains(requested, allProviders) {
+		return t.getOrderedProviders(searchType, ""), nil, true
+	}
+	if len(requested) < 2 {
+		return nil, nil, false
+	}
+
+	skipped = make(map[string]string)
+	for _, name := range t.getOrderedProviders(searchType, "") {
+		if slices.Contains(requested, name) {
+			names = append(names, name)
+		}
+	}
+	// Site providers are never in the default order, so they're added only when listed
+	for _, name := range siteProviders {
+		if slices.Contains(requested, name) && len(t.getOrderedProviders(searchType, name)) > 0 {
+			names = append(names, name)
+		}
+	}
+	for _, name := range requested {
+		if !slices.Contains(names, name) {
+			skipped[name] = fmt.Sprintf("not available or does not support %s search", searchType)
+		}
+	}
+	return names, skipped, true
+}
+
+// parseMergeStrategy reads the optional merge_strategy argument, defaulting to interleave
+func parseMergeStrategy(args map[string]any) (string, error) {
+	strategy, ok := args["merge_strategy"].(string)
+	if !ok || strategy == "" {
+		return MergeInterleave, nil
+	}
+	switch strategy {
+	case MergeInterleave, MergeGrouped:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("invalid merge_strategy %q, must be one of: %s, %s", strategy, MergeInterleave, MergeGrouped)
+	}
+}
+
+// executeFederated runs the search against several providers concurrently and merges the results.
+// Each provider has its own timeout so a slow provider can't hold up the merged response.
+func (t *InternetSearchTool) executeFederated(ctx context.Context, logger *logrus.Logger, searchType string, providerNames []string, skipped map[string]string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	strategy, err := parseMergeStrategy(args)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := internetsearch.ParseDomainFilter(args)
+	if err != nil {
+		return nil, err
+	}
+	language, err := internetsearch.ParseResultLanguage(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(providerNames) == 0 {
+		return nil, fmt.Errorf("no requested providers support search type: %s", searchType)
+	}
+
+	timeout, err := t.parseSearchTimeout(args)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"providers":      providerNames,
+		"type":           searchType,
+		"merge_strategy": strategy,
+	}).Info("Executing federated internet search")
+
+	outcomes := make([]providerOutcome, len(providerNames))
+	var wg sync.WaitGroup
+	for i, name := range providerNames {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			outcomes[i] = t.searchWithTimeout(ctx, logger, name, searchType, args, timeout)
+		}(i, name)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("search cancelled: %w", err)
+	}
+
+	providerErrors := make(map[string]string)
+	for name, reason := range skipped {
+		providerErrors[name] = reason
+	}
+
+	var succeeded []*providerOutcome
+	var failures []error
+	for i := range outcomes {
+		outcome := &outcomes[i]
+		if outcome.err == nil {
+			applyDomainFilter(outcome.response, domains)
+			addHostMetadata(outcome.response)
+			applyLanguageFilter(outcome.response, language)
+			addPublishedDates(outcome.response)
+			outcome.err = analyseResults(logger, outcome.provider, outcome.response)
+		}
+		if outcome.err != nil {
+			providerErrors[outcome.provider] = withErrorHint(outcome.err).Error()
+			failures = append(failures, providerFailure(outcome.provider, outcome.err))
+			logger.WithFields(logrus.Fields{
+				"provider": outcome.provider,
+				"error":    outcome.err,
+			}).Warn("Provider failed during federated search")
+			continue
+		}
+		succeeded = append(succeeded, outcome)
+	}
+
+	if len(succeeded) == 0 {
+		return nil, &allProvidersFailedError{errs: failures}
+	}
+
+	return mergeFederated(succeeded, strategy, providerErrors), nil
+}
+
+// mergeFederated combines provider responses using the merge strategy, dropping duplicate URLs in favour
+// of the first (higher ranked) occurrence and recording each result's provider and original rank
+func mergeFederated(outcomes []*providerOutcome, strategy string, providerErrors map[string]string) *internetsearch.SearchResponse {
+	type rankedResult struct {
+		provider string
+		rank     int
+		result   internetsearch.SearchResult
+	}
+
+	var ordered []rankedResult
+	switch strategy {
+	case MergeGrouped:
+		for _, outcome := range outcomes {
+			for i, result := range outcome.response.Results {
+				ordered = append(ordered, rankedResult{provider: outcome.provider, rank: i + 1, result: result})
+			}
+		}
+	default:
+		for rank := 0; ; rank++ {
+			added := false
+			for _, outcome := range outcomes {
+				if rank < len(outcome.response.Results) {
+					ordered = append(ordered, rankedResult{provider: outcome.provider, rank: rank + 1, result: outcome.response.Results[rank]})
+					added = true
+				}
+			}
+			if !added {
+				break
+			}
+		}
+	}
+
+	merged := &internetsearch.SearchResponse{
+		Results:   make([]internetsearch.SearchResult, 0, len(ordered)),
+		Timestamp: time.Now(),
+	}
+
+	seen := make(map[string]int)
+	duplicates := 0
+	for _, item := range ordered {
+		key := internetsearch.CanonicalURL(item.result.URL)
+		if index, exists := seen[key]; exists && key != "" {
+			// Record the other providers that found the same page on the kept result
+			kept := merged.Results[index].Metadata
+			alsoFoundBy, _ := kept["also_found_by"].([]string)
+			if item.provider != kept["provider"] && !slices.Contains(alsoFoundBy, item.provider) {
+				kept["also_found_by"] = append(alsoFoundBy, item.provider)
+			}
+			duplicates++
+			continue
+		}
+
+		result := item.result
+		metadata := make(map[string]any, len(result.Metadata)+3)
+		for key, value := range result.Metadata {
+			metadata[key] = value
+		}
+		metadata["provider"] = item.provider
+		metadata["provider_rank"] = item.rank
+		metadata["position"] = len(merged.Results) + 1
+		result.Metadata = metadata
+
+		seen[key] = len(merged.Results)
+		merged.Results = append(merged.Results, result)
+	}
+
+	providerNames := make([]string, 0, len(outcomes))
+	providerMetadata := make(map[string]any)
+	for _, outcome := range outcomes {
+		providerNames = append(providerNames, outcome.provider)
+		if merged.Region == "" {
+			merged.Region = outcome.response.Region
+		}
+		duplicates += outcome.response.DuplicatesRemoved
+		if len(outcome.response.Metadata) > 0 {
+			providerMetadata[outcome.provider] = outcome.response.Metadata
+		}
+	}
+
+	merged.Provider = strings.Join(providerNames, ",")
+	merged.DuplicatesRemoved = duplicates
+	merged.SetMetadata("providers", providerNames)
+	merged.SetMetadata("merge_strategy", strategy)
+	if len(providerErrors) > 0 {
+		merged.SetMetadata("provider_errors", providerErrors)
+	}
+	if len(providerMetadata) > 0 {
+		merged.SetMetadata("provider_metadata", providerMetadata)
+	}
+
+	return merged
+}
@@ -1,8 +1,11 @@
 package unified
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
@@ -13,17 +16,26 @@ import (
 	"github.com/sammcj/mcp-devtools/internal/security"
 	"github.com/sammcj/mcp-devtools/internal/tools"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/arxiv"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/brave"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/duckduckgo"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/github"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/google"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/hackernews"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/kagi"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/perplexity"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/searxng"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/tavily"
 	"github.com/sirupsen/logrus"
 )
 
 // InternetSearchTool provides a single interface for multiple search providers
 type InternetSearchTool struct {
-	providers map[string]SearchProvider
+	providers      map[string]SearchProvider
+	unconfigured   []SearchProvider // Providers missing their configuration, kept so list_providers can report what they need
+	searchCache    searchCache
+	searchTimeout  time.Duration // Overrides the default per-provider timeout when timeout_seconds isn't given
+	contentFetcher *contentFetcher
 }
 
 // SearchProvider defines the interface all search providers must implement
@@ -32,6 +44,7 @@ type SearchProvider interface {
 	GetName() string
 	IsAvailable() bool
 	GetSupportedTypes() []string
+	Describe() internetsearch.ProviderDescription
 }
 
 const (
@@ -40,28 +53,53 @@ const (
 )
 
 // providerPriorityOrder defines the order providers are tried during fallback
-var providerPriorityOrder = []string{"brave", "google", "kagi", "searxng", "duckduckgo"}
+var providerPriorityOrder = []string{"brave", "google", "kagi", "tavily", "perplexity", "searxng", "duckduckgo"}
+
+// siteProviders search a single site rather than the wider web, so they are only used when requested
+// by name and are never tried as fallbacks or included in "all" searches
+var siteProviders = []string{"hackernews"}
 
 func init() {
 	tool := &InternetSearchTool{
-		providers: make(map[string]SearchProvider),
+		providers:      make(map[string]SearchProvider),
+		contentFetcher: newContentFetcher(),
 	}
 
 	// Register available providers
 	if braveProvider := brave.NewBraveProvider(); braveProvider != nil && braveProvider.IsAvailable() {
 		tool.providers["brave"] = braveProvider
+	} else {
+		tool.unconfigured = append(tool.unconfigured, &brave.BraveProvider{})
 	}
 
 	if googleProvider := google.NewGoogleProvider(); googleProvider != nil && googleProvider.IsAvailable() {
 		tool.providers["google"] = googleProvider
+	} else {
+		tool.unconfigured = append(tool.unconfigured, &google.GoogleProvider{})
 	}
 
 	if kagiProvider := kagi.NewKagiProvider(); kagiProvider != nil && kagiProvider.IsAvailable() {
 		tool.providers["kagi"] = kagiProvider
+	} else {
+		tool.unconfigured = append(tool.unconfigured, &kagi.KagiProvider{})
+	}
+
+	if tavilyProvider := tavily.NewTavilyProvider(); tavilyProvider != nil && tavilyProvider.IsAvailable() {
+		tool.providers["tavily"] = tavilyProvider
+	} else {
+		tool.unconfigured = append(tool.unconfigured, &tavily.TavilyProvider{})
+	}
+
+	if perplexityProvider := perplexity.NewPerplexityProvider(); perplexityProvider != nil && perplexityProvider.IsAvailable() {
+		tool.providers["perplexity"] = perplexityProvider
+	} else {
+		tool.unconfigured = append(tool.unconfigured, &perplexity.PerplexityProvider{})
 	}
 
 	if searxngProvider := searxng.NewSearXNGProvider(); searxngProvider != nil && searxngProvider.IsAvailable() {
 		tool.providers["searxng"] = searxngProvider
+	} else {
+		tool.unconfigured = append(tool.unconfigured, &searxng.SearXNGProvider{})
 	}
 
 	// DuckDuckGo is always available since it doesn't require an API key
@@ -69,6 +107,21 @@ func init() {
 		tool.providers["duckduckgo"] = duckduckgoProvider
 	}
 
+	// GitHub is always available, GITHUB_TOKEN raises its rate limits and enables code search
+	if githubProvider := github.NewGitHubProvider(); githubProvider != nil && githubProvider.IsAvailable() {
+		tool.providers["github"] = githubProvider
+	}
+
+	// Hacker News is always available since the Algolia API doesn't require a key
+	if hackerNewsProvider := hackernews.NewHackerNewsProvider(); hackerNewsProvider != nil && hackerNewsProvider.IsAvailable() {
+		tool.providers["hackernews"] = hackerNewsProvider
+	}
+
+	// arXiv is always available since the export API doesn't require a key
+	if arxivProvider := arxiv.NewArxivProvider(); arxivProvider != nil && arxivProvider.IsAvailable() {
+		tool.providers["arxiv"] = arxivProvider
+	}
+
 	// Only register if we have at least one provider
 	if len(tool.providers) > 0 {
 		registry.Register(tool)
@@ -96,28 +149,18 @@ func (t *InternetSearchTool) Definition() mcp.Tool {
 		typesList = append(typesList, searchType)
 	}
 
-	// Default provider based on priority order
-	var defaultProvider string
-	for _, providerName := range providerPriorityOrder {
-		if _, exists := t.providers[providerName]; exists {
-			defaultProvider = providerName
-			break
-		}
-	}
-
-	// If no provider from priority list, use first available
-	if defaultProvider == "" {
-		for name := range t.providers {
-			defaultProvider = name
-			break
-		}
-	}
+	defaultProvider := t.defaultProvider()
 
 	// Check which providers are available
 	_, hasBrave := t.providers["brave"]
 	_, hasGoogle := t.providers["google"]
 	_, hasKagi := t.providers["kagi"]
 	_, hasSearXNG := t.providers["searxng"]
+	_, hasTavily := t.providers["tavily"]
+	_, hasPerplexity := t.providers["perplexity"]
+	_, hasHackerNews := t.providers["hackernews"]
+	_, hasGitHub := t.providers["github"]
+	_, hasArxiv := t.providers["arxiv"]
 
 	// Build provider-specific parameter description
 	var providerSpecificParams []string
@@ -125,13 +168,41 @@ func (t *InternetSearchTool) Definition() mcp.Tool {
 		providerSpecificParams = append(providerSpecificParams, "- Brave: freshness (pd/pw/pm/py), offset (internet search only)")
 	}
 	if hasGoogle {
-		providerSpecificParams = append(providerSpecificParams, "- Google: start (pagination offset)")
+		providerSpecificParams = append(providerSpecificParams, "- Google: start (pagination offset), safe (active/off), site (restrict to a site)")
 	}
 	if hasKagi {
 		providerSpecificParams = append(providerSpecificParams, "- Kagi: No provider-specific parameters")
 	}
+	if hasTavily {
+		providerSpecificParams = append(providerSpecificParams, "- Tavily: search_depth (basic/advanced), include_answer")
+	}
+	if hasPerplexity {
+		providerSpecificParams = append(providerSpecificParams, "- Perplexity: answer type only, returns an answer plus citation results")
+	}
 	if hasSearXNG {
-		providerSpecificParams = append(providerSpecificParams, "- SearXNG: pageno, time_range (day/month/year), language, safesearch")
+		providerSpecificParams = append(providerSpecificParams, "- SearXNG: pageno, language")
+	}
+	if hasGitHub {
+		providerSpecificParams = append(providerSpecificParams, "- GitHub: repositories and code types only, query accepts GitHub qualifiers (language:, org:, repo:, path:)")
+	}
+	if hasHackerNews {
+		providerSpecificParams = append(providerSpecificParams, "- Hacker News: hn_item_type (story/comment/ask_hn/show_hn/all), sort (relevance/date), only used when requested by name")
+	}
+	if hasArxiv {
+		providerSpecificParams = append(providerSpecificParams, "- arXiv: papers type only, arxiv_title, arxiv_author, arxiv_abstract, arxiv_category, start (pagination offset), sort (relevance/date)")
+	}
+
+	// Answer, repository, code and paper searches are only offered when a provider serves them
+	typeExamples := ""
+	if hasPerplexity {
+		typeExamples = "\n- Answer with citations: {\"type\": \"answer\", \"query\": \"what changed in Go 1.23\"}"
+	}
+	if hasGitHub {
+		typeExamples += "\n- GitHub repositories: {\"type\": \"repositories\", \"query\": \"http router language:go\"}"
+		typeExamples += "\n- GitHub code (needs GITHUB_TOKEN): {\"type\": \"code\", \"query\": \"WithTimeout repo:golang/go\"}"
+	}
+	if hasArxiv {
+		typeExamples += "\n- arXiv papers: {\"type\": \"papers\", \"query\": \"speculative decoding\", \"arxiv_category\": \"cs.CL\", \"sort\": \"date\"}"
 	}
 
 	description := fmt.Sprintf(`Search the internet for information and links.
@@ -143,24 +214,29 @@ Search Types: %v
 
 Automatic Fallback: If a provider fails (e.g., rate limited), the tool automatically retries with other available providers that support the requested search type. This ensures reliable search results even when primary providers are temporarily unavailable. To disable fallback and use only one provider, specify it explicitly with the 'provider' parameter.
 
+Provider Listing: Use {"action": "list_providers"} to see every provider, whether it is available, its search types and the environment variables it needs. Add "check": true to test each available provider with a one-result search.
+
+Multiple Providers: Set 'provider' to "all" or pass a 'providers' list to search several providers concurrently. Results are merged, duplicate URLs removed, and each result's metadata records its provider and original rank. Providers that fail or time out are listed in the response's 'provider_errors' metadata.
+
 Examples:
 - Internet search: {"query": "golang best practices", "count": 10}
 - Image search: {"type": "image", "query": "golang gopher mascot", "count": 3}
 - News search: {"type": "news", "query": "AI breakthrough", "time_range": "day"}
-- Video search: {"type": "video", "query": "golang tutorial"}
+- Video search: {"type": "video", "query": "golang tutorial"}%s
 
 Provider-specific optional parameters:
 %s
 
 After you have received the results you can fetch the url if you want to read the full content.
 `,
-		strings.Join(availableProviders, ", "), defaultProvider, typesList, strings.Join(providerSpecificParams, "\n"))
+		strings.Join(availableProviders, ", "), defaultProvider, typesList, typeExamples, strings.Join(providerSpecificParams, "\n"))
 
 	enumValues := make([]string, 0, len(typesList))
 	enumValues = append(enumValues, typesList...)
 
-	providerEnumValues := make([]string, 0, len(availableProviders))
+	providerEnumValues := make([]string, 0, len(availableProviders)+1)
 	providerEnumValues = append(providerEnumValues, availableProviders...)
+	providerEnumValues = append(providerEnumValues, allProviders)
 
 	// Start building the tool definition with common parameters
 	toolOptions := []mcp.ToolOption{
@@ -171,18 +247,75 @@ After you have received the results you can fetch the url if you want to read th
 			mcp.Enum(enumValues...),
 		),
 		mcp.WithString("query",
-			mcp.Required(),
-			mcp.Description("Search query term"),
+			mcp.Description("Search query term (required unless action is list_providers)"),
+		),
+		mcp.WithString("action",
+			mcp.Description("'search' (default) or 'list_providers' to describe every provider, its supported types and the environment variables it needs"),
+			mcp.DefaultString(actionSearch),
+			mcp.Enum(actionSearch, actionListProviders),
+		),
+		mcp.WithBoolean("check",
+			mcp.Description("With list_providers, run a one-result search against each available provider and report its latency or failure (default: false)"),
 		),
 		mcp.WithString("provider",
 			mcp.Description(fmt.Sprintf("Search provider to use (default: %s)", defaultProvider)),
 			mcp.DefaultString(defaultProvider),
 			mcp.Enum(providerEnumValues...),
 		),
+		mcp.WithArray("providers",
+			mcp.Description("Search several providers at once and merge the results (e.g., [\"brave\", \"duckduckgo\"]). Duplicate URLs are removed"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("merge_strategy",
+			mcp.Description("How results from several providers are merged: interleave by rank (default) or grouped by provider"),
+			mcp.Enum(MergeInterleave, MergeGrouped),
+		),
 		mcp.WithNumber("count",
 			mcp.Description("Number of results (limits vary by provider & type)"),
 			mcp.DefaultNumber(5),
 		),
+		mcp.WithString("safesearch",
+			mcp.Description("Safe search filter (off/moderate/strict), mapped to each provider's equivalent"),
+			mcp.Enum(internetsearch.SafeSearchOff, internetsearch.SafeSearchModerate, internetsearch.SafeSearchStrict),
+			mcp.DefaultString(internetsearch.SafeSearchModerate),
+		),
+		mcp.WithString("time_range",
+			mcp.Description("Only return results from the past day/week/month/year, mapped to each provider's freshness option"),
+			mcp.Enum(internetsearch.TimeRangeDay, internetsearch.TimeRangeWeek, internetsearch.TimeRangeMonth, internetsearch.TimeRangeYear),
+		),
+		mcp.WithArray("include_domains",
+			mcp.Description("Only return results from these domains, including their subdomains (e.g., ['go.dev'] also matches pkg.go.dev)"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("exclude_domains",
+			mcp.Description("Drop results from these domains, including their subdomains"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("result_language",
+			mcp.Description("Only return results detected as this ISO 639-1 language (e.g., 'en', 'de', 'ja'), judged from their title and description"),
+		),
+		mcp.WithBoolean("sort_by_date",
+			mcp.Description("Order results newest first by their 'published_at' metadata when at least half have one (default: false)"),
+		),
+		mcp.WithNumber("fetch_content",
+			mcp.Description("Fetch the pages of the top N results and attach the start of their text as 'content' metadata (default: 0, max: 10)"),
+			mcp.Min(0),
+			mcp.Max(maxFetchContent),
+		),
+		mcp.WithNumber("timeout_seconds",
+			mcp.Description(fmt.Sprintf("Timeout for each provider call in seconds (default: %s, from INTERNET_SEARCH_TIMEOUT)", getSearchTimeout())),
+			mcp.Min(minSearchTimeout.Seconds()),
+			mcp.Max(internetsearch.MaxSearchTimeout.Seconds()),
+		),
+		mcp.WithBoolean("debug",
+			mcp.Description("Save each raw provider response under ~/.mcp-devtools/debug and report the files, status codes and headers in 'debug_responses' metadata (default: false)"),
+		),
+		mcp.WithBoolean("no_cache",
+			mcp.Description("Bypass cached results and refresh them (default: false)"),
+		),
+		mcp.WithString("region",
+			mcp.Description("Region for localised results as a DuckDuckGo region code (e.g., 'us-en', 'uk-en', 'de-de', 'au-en'). Mapped to each provider's market/locale option"),
+		),
 	}
 
 	// Add provider-specific parameters only if the provider is available
@@ -198,33 +331,83 @@ After you have received the results you can fetch the url if you want to read th
 		)
 	}
 
-	if hasGoogle {
+	if hasGoogle || hasArxiv {
 		toolOptions = append(toolOptions,
 			mcp.WithNumber("start",
-				mcp.Description("Start index for Google search pagination (default: 0)"),
+				mcp.Description("Start index for Google (1-based) and arXiv (zero-based) pagination (default: 0)"),
 				mcp.DefaultNumber(0),
 			),
 		)
 	}
 
+	if hasGoogle {
+		toolOptions = append(toolOptions,
+			mcp.WithString("safe",
+				mcp.Description("Safe search for Google (active/off)"),
+				mcp.Enum("active", "off"),
+			),
+			mcp.WithString("site",
+				mcp.Description("Restrict Google results to a site (e.g., 'go.dev')"),
+			),
+		)
+	}
+
+	if hasTavily {
+		toolOptions = append(toolOptions,
+			mcp.WithString("search_depth",
+				mcp.Description("Search depth for Tavily (basic/advanced)"),
+				mcp.Enum("basic", "advanced"),
+			),
+			mcp.WithBoolean("include_answer",
+				mcp.Description("Include Tavily's synthesised answer as the first result (default: true)"),
+			),
+		)
+	}
+
 	if hasSearXNG {
 		toolOptions = append(toolOptions,
 			mcp.WithNumber("pageno",
 				mcp.Description("Page number for SearXNG (starts at 1)"),
 				mcp.DefaultNumber(1),
 			),
-			mcp.WithString("time_range",
-				mcp.Description("Time range for SearXNG (day/month/year)"),
-				mcp.Enum("day", "month", "year"),
-			),
 			mcp.WithString("language",
 				mcp.Description("Language code for SearXNG (e.g., 'all', 'en', 'fr', 'de')"),
 				mcp.DefaultString("en"),
 			),
-			mcp.WithString("safesearch",
-				mcp.Description("Safe search filter for SearXNG (0: None, 1: Moderate, 2: Strict)"),
-				mcp.Enum("0", "1", "2"),
-				mcp.DefaultString("1"),
+		)
+	}
+
+	if hasHackerNews {
+		toolOptions = append(toolOptions,
+			mcp.WithString("hn_item_type",
+				mcp.Description("Hacker News items to search (default: story)"),
+				mcp.Enum("story", "comment", "ask_hn", "show_hn", "all"),
+			),
+		)
+	}
+
+	if hasArxiv {
+		toolOptions = append(toolOptions,
+			mcp.WithString("arxiv_title",
+				mcp.Description("Only return arXiv papers whose title contains this phrase"),
+			),
+			mcp.WithString("arxiv_author",
+				mcp.Description("Only return arXiv papers by this author (e.g., 'hinton')"),
+			),
+			mcp.WithString("arxiv_abstract",
+				mcp.Description("Only return arXiv papers whose abstract contains this phrase"),
+			),
+			mcp.WithString("arxiv_category",
+				mcp.Description("Only return arXiv papers in this category (e.g., 'cs.CL', 'quant-ph')"),
+			),
+		)
+	}
+
+	if hasHackerNews || hasArxiv {
+		toolOptions = append(toolOptions,
+			mcp.WithString("sort",
+				mcp.Description("Hacker News and arXiv ordering: relevance, or date for newest first (default: relevance, except date for Hacker News news searches)"),
+				mcp.Enum(internetsearch.SortRelevance, internetsearch.SortDate),
 			),
 		)
 	}
@@ -242,6 +425,15 @@ After you have received the results you can fetch the url if you want to read th
 
 // Execute executes the unified search tool
 func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger, cache *sync.Map, args map[string]any) (*mcp.CallToolResult, error) {
+	action, _ := args["action"].(string)
+	switch action {
+	case "", actionSearch:
+	case actionListProviders:
+		return t.listProviders(ctx, logger, args)
+	default:
+		return nil, fmt.Errorf("invalid action %q, must be one of: %s, %s", action, actionSearch, actionListProviders)
+	}
+
 	// Parse parameters (with default for type)
 	searchType, ok := args["type"].(string)
 	if !ok || searchType == "" {
@@ -253,10 +445,79 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 		return nil, fmt.Errorf("missing required parameter 'query'. Provide search terms (e.g., {\"query\": \"golang best practices\"} or {\"query\": \"how to optimise React performance\"})")
 	}
 
+	// Validate shared filters up front so an invalid value isn't retried against every provider
+	if _, err := internetsearch.ParseRegion(args); err != nil {
+		return nil, err
+	}
+	if _, err := internetsearch.ParseSafeSearch(args); err != nil {
+		return nil, err
+	}
+	if _, err := internetsearch.ParseTimeRange(args); err != nil {
+		return nil, err
+	}
+	domains, err := internetsearch.ParseDomainFilter(args)
+	if err != nil {
+		return nil, err
+	}
+	language, err := internetsearch.ParseResultLanguage(args)
+	if err != nil {
+		return nil, err
+	}
+	sortByDate, _ := args["sort_by_date"].(bool)
+	fetchCount, err := parseFetchContent(args)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := t.parseSearchTimeout(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Debug searches record the raw provider responses, so they always go to the providers
+	capture := startDebugCapture(logger, args)
+	if capture != nil {
+		ctx = internetsearch.WithDebugCapture(ctx, capture)
+	}
+
+	// Serve repeated searches from the cache unless the caller asked for fresh results
+	cacheTTL := getSearchCacheTTL()
+	useCache := cache != nil && cacheTTL > 0 && capture == nil
+	noCache, _ := args["no_cache"].(bool)
+
+	// Fan out to several providers when more than one (or "all") was requested
+	if federatedNames, skipped, ok := t.federatedProviders(searchType, args); ok {
+		cacheKey := searchCacheKey(federatedCacheProvider, searchType, query, args)
+		if useCache && !noCache {
+			if response, ok := t.searchCache.load(cache, cacheKey); ok {
+				logger.WithField("cache_key", cacheKey).Debug("Using cached search response")
+				return internetsearch.NewToolResultJSON(response)
+			}
+		}
+
+		response, err := t.executeFederated(ctx, logger, searchType, federatedNames, skipped, args)
+		if err != nil {
+			return nil, err
+		}
+		applyDateSort(response, sortByDate)
+		attachDebugResponses(response, capture)
+		t.attachContent(ctx, logger, response, fetchCount)
+		if useCache {
+			t.searchCache.store(cache, logger, cacheKey, response, cacheTTL)
+		}
+		return internetsearch.NewToolResultJSON(response)
+	}
+
 	// Determine if user explicitly requested a specific provider
 	userRequestedProvider := ""
 	if providerRaw, ok := args["provider"].(string); ok && providerRaw != "" {
 		userRequestedProvider = providerRaw
+	} else if requested := internetsearch.StringSliceArg(args, "providers"); len(requested) == 1 {
+		userRequestedProvider = requested[0]
+	}
+	if userRequestedProvider != "" {
+		if err := t.checkRequestedProvider(userRequestedProvider, searchType); err != nil {
+			return nil, err
+		}
 	}
 
 	// Get ordered list of providers to try (with fallback support)
@@ -265,8 +526,20 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 		return nil, fmt.Errorf("no available providers support search type: %s", searchType)
 	}
 
+	if useCache && !noCache {
+		for _, providerName := range providersToTry {
+			cacheKey := searchCacheKey(providerName, searchType, query, args)
+			if response, ok := t.searchCache.load(cache, cacheKey); ok {
+				logger.WithField("cache_key", cacheKey).Debug("Using cached search response")
+				return internetsearch.NewToolResultJSON(response)
+			}
+		}
+	}
+
 	// Track errors from each provider attempt
 	var allErrors []string
+	var failures []error
+	var lastErr error
 
 	// Try each provider in order
 	for i, providerName := range providersToTry {
@@ -276,8 +549,7 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 		}
 
 		// Add delay between fallback attempts to avoid rapid-fire rate limiting
-		if i > 0 {
-			delay := time.Duration(i) * fallbackBaseDelay // 1s, 2s, 3s, etc.
+		if delay := fallbackDelay(i, lastErr); i > 0 && delay > 0 {
 			logger.WithField("delay", delay).Debug("Delaying before fallback attempt")
 
 			// Use context-aware sleep to allow cancellation
@@ -314,17 +586,25 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 		}
 
 		// Execute search with the selected provider
-		response, err := provider.Search(ctx, logger, searchType, args)
+		outcome := t.searchWithTimeout(ctx, logger, providerName, searchType, args, timeout)
+		response, err := outcome.response, outcome.err
 		if err != nil {
-			errorMsg := fmt.Sprintf("%s: %v", providerName, err)
-			allErrors = append(allErrors, errorMsg)
+			failure := providerFailure(providerName, err)
+			failures = append(failures, failure)
+			allErrors = append(allErrors, failure.Error())
+			lastErr = err
 
 			// If this was user-requested provider or last provider, return error
 			if userRequestedProvider != "" || i == len(providersToTry)-1 {
-				if len(allErrors) > 1 {
-					return nil, fmt.Errorf("all providers failed: %s", strings.Join(allErrors, "; "))
+				if len(failures) > 1 {
+					return nil, &allProvidersFailedError{errs: failures}
 				}
-				return nil, fmt.Errorf("search failed with provider %s: %w", providerName, err)
+				// A timeout already names the provider
+				var timeoutErr *internetsearch.TimeoutError
+				if errors.As(err, &timeoutErr) {
+					return nil, withErrorHint(err)
+				}
+				return nil, fmt.Errorf("search failed with provider %w", failure)
 			}
 
 			// Log the error and continue to next provider
@@ -335,33 +615,14 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 			continue
 		}
 
+		applyDomainFilter(response, domains)
+		addHostMetadata(response)
+		applyLanguageFilter(response, language)
+		addPublishedDates(response)
+
 		// Analyse search results for security threats
-		if security.IsEnabled() && response != nil {
-			for resultIdx, result := range response.Results {
-				source := security.SourceContext{
-					Tool:        "internet_search",
-					Domain:      providerName,
-					ContentType: "search_results",
-				}
-				// Analyse the search result content
-				content := result.Title + " " + result.Description
-				if secResult, err := security.AnalyseContent(content, source); err == nil {
-					switch secResult.Action {
-					case security.ActionBlock:
-						return nil, fmt.Errorf("search result blocked by security policy: %s", secResult.Message)
-					case security.ActionWarn:
-						// Add security notice to result metadata
-						if result.Metadata == nil {
-							result.Metadata = make(map[string]any)
-						}
-						result.Metadata["security_warning"] = secResult.Message
-						result.Metadata["security_id"] = secResult.ID
-						logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
-					}
-					// Update the result in the response
-					response.Results[resultIdx] = result
-				}
-			}
+		if err := analyseResults(logger, providerName, response); err != nil {
+			return nil, err
 		}
 
 		// Success! Add metadata if this was a fallback
@@ -382,6 +643,14 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 			}).Info("Search succeeded with fallback provider")
 		}
 
+		applyDateSort(response, sortByDate)
+		attachDebugResponses(response, capture)
+		t.attachContent(ctx, logger, response, fetchCount)
+
+		if useCache && response != nil {
+			t.searchCache.store(cache, logger, searchCacheKey(providerName, searchType, query, args), response, cacheTTL)
+		}
+
 		return internetsearch.NewToolResultJSON(response)
 	}
 
@@ -389,7 +658,198 @@ func (t *InternetSearchTool) Execute(ctx context.Context, logger *logrus.Logger,
 	return nil, fmt.Errorf("no providers could complete the search")
 }
 
+// startDebugCapture returns a capture for the search's raw provider responses when debug: true or SEARCH_DEBUG
+// is set, or nil otherwise. A debug directory that can't be created is logged rather than failing the search.
+func startDebugCapture(logger *logrus.Logger, args map[string]any) *internetsearch.DebugCapture {
+	if !internetsearch.DebugEnabled(args) {
+		return nil
+	}
+	capture, err := internetsearch.NewDebugCapture(logger)
+	if err != nil {
+		logger.WithError(err).Warn("Search debug capture unavailable")
+		return nil
+	}
+	return capture
+}
+
+// attachDebugResponses records the captured raw responses, including those from providers that failed
+// before a fallback succeeded, in the response metadata
+func attachDebugResponses(response *internetsearch.SearchResponse, capture *internetsearch.DebugCapture) {
+	if response == nil || capture == nil {
+		return
+	}
+	response.SetMetadata("debug_responses", capture.Responses())
+}
+
+// attachContent fetches the pages of the top results when fetch_content was requested
+func (t *InternetSearchTool) attachContent(ctx context.Context, logger *logrus.Logger, response *internetsearch.SearchResponse, count int) {
+	if count == 0 {
+		return
+	}
+	fetcher := t.contentFetcher
+	if fetcher == nil {
+		fetcher = newContentFetcher()
+	}
+	fetcher.fetchContent(ctx, logger, response, count, getFetchContentTimeout())
+}
+
+// applyDomainFilter removes results outside the requested domains, covering providers without native
+// domain filtering and site: operators dropped beyond the cap
+func applyDomainFilter(response *internetsearch.SearchResponse, domains internetsearch.DomainFilter) {
+	if response == nil || domains.IsEmpty() {
+		return
+	}
+
+	var removed int
+	response.Results, removed = domains.Apply(response.Results)
+	if removed > 0 {
+		previous, _ := response.Metadata["domain_filtered"].(int)
+		response.SetMetadata("domain_filtered", previous+removed)
+	}
+}
+
+// addHostMetadata records the domain, host and favicon of each result
+func addHostMetadata(response *internetsearch.SearchResponse) {
+	if response != nil {
+		internetsearch.AddHostMetadata(response.Results)
+	}
+}
+
+// applyLanguageFilter records the detected language of each result and, when result_language was requested,
+// removes results that aren't confidently in it
+func applyLanguageFilter(response *internetsearch.SearchResponse, language string) {
+	if response == nil {
+		return
+	}
+
+	internetsearch.AddLanguageMetadata(response.Results)
+	if language == "" {
+		return
+	}
+	var removed int
+	response.Results, removed = internetsearch.FilterLanguage(response.Results, language)
+	if removed > 0 {
+		previous, _ := response.Metadata["language_filtered"].(int)
+		response.SetMetadata("language_filtered", previous+removed)
+	}
+}
+
+// addPublishedDates records each result's published_at, counting relative snippet dates back from
+// when the provider responded
+func addPublishedDates(response *internetsearch.SearchResponse) {
+	if response == nil {
+		return
+	}
+	now := response.Timestamp
+	if now.IsZero() {
+		now = time.Now()
+	}
+	internetsearch.AddPublishedDates(response.Results, now)
+}
+
+// applyDateSort orders results newest first when sort_by_date was requested, noting in the response
+// metadata when too few results have a date and they were left in rank order
+func applyDateSort(response *internetsearch.SearchResponse, sortByDate bool) {
+	if response == nil || !sortByDate {
+		return
+	}
+	if !internetsearch.SortByDate(response.Results) {
+		response.SetMetadata("sort_by_date_skipped", "fewer than half of the results have a published date, results are in rank order")
+	}
+}
+
+// analyseResults checks each search result for security threats, annotating warnings in the result metadata
+func analyseResults(logger *logrus.Logger, providerName string, response *internetsearch.SearchResponse) error {
+	if security.IsEnabled() && response != nil {
+		for resultIdx, result := range response.Results {
+			source := security.SourceContext{
+				Tool:        "internet_search",
+				Domain:      providerName,
+				ContentType: "search_results",
+			}
+			// Analyse the search result content
+			content := result.Title + " " + result.Description
+			if secResult, err := security.AnalyseContent(content, source); err == nil {
+				switch secResult.Action {
+				case security.ActionBlock:
+					return fmt.Errorf("search result blocked by security policy: %s", secResult.Message)
+				case security.ActionWarn:
+					// Add security notice to result metadata
+					if result.Metadata == nil {
+						result.Metadata = make(map[string]any)
+					}
+					result.Metadata["security_warning"] = secResult.Message
+					result.Metadata["security_id"] = secResult.ID
+					logger.WithField("security_id", secResult.ID).Warn(secResult.Message)
+				}
+				// Update the result in the response
+				response.Results[resultIdx] = result
+			}
+		}
+	}
+	return nil
+}
+
 // Helper methods
+
+// defaultProvider returns the highest priority available provider, or the first available by name
+// when none of the priority providers are configured
+func (t *InternetSearchTool) defaultProvider() string {
+	for _, providerName := range providerPriorityOrder {
+		if _, exists := t.providers[providerName]; exists {
+			return providerName
+		}
+	}
+
+	names := make([]string, 0, len(t.providers))
+	for name := range t.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// checkRequestedProvider explains why a provider named by the caller can't run the search, so a forced
+// provider never silently falls back to another. It returns nil when the provider can be used.
+func (t *InternetSearchTool) checkRequestedProvider(providerName, searchType string) error {
+	if provider, exists := t.providers[providerName]; exists {
+		if t.providerSupportsType(provider, searchType) {
+			return nil
+		}
+		return fmt.Errorf("provider %q does not support %s search, it supports: %s. Available providers: %s",
+			providerName, searchType, strings.Join(provider.GetSupportedTypes(), ", "), t.availableProvidersSummary())
+	}
+
+	for _, provider := range t.unconfigured {
+		if provider.GetName() == providerName {
+			return fmt.Errorf("provider %q is not available, it needs %s to be set. Available providers: %s",
+				providerName, strings.Join(provider.Describe().RequiredEnvVars, " and "), t.availableProvidersSummary())
+		}
+	}
+	return fmt.Errorf("unknown provider %q. Available providers: %s", providerName, t.availableProvidersSummary())
+}
+
+// availableProvidersSummary lists the available providers in fallback priority order with their search types,
+// e.g. "brave (web, news), duckduckgo (web)"
+func (t *InternetSearchTool) availableProvidersSummary() string {
+	names := slices.Collect(maps.Keys(t.providers))
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Or(cmp.Compare(priorityRank(a), priorityRank(b)), cmp.Compare(a, b))
+	})
+
+	summaries := make([]string, 0, len(names))
+	for _, name := range names {
+		summaries = append(summaries, fmt.Sprintf("%s (%s)", name, strings.Join(t.providers[name].GetSupportedTypes(), ", ")))
+	}
+	if len(summaries) == 0 {
+		return "none"
+	}
+	return strings.Join(summaries, ", ")
+}
+
 func (t *InternetSearchTool) providerSupportsType(provider SearchProvider, searchType string) bool {
 	return slices.Contains(provider.GetSupportedTypes(), searchType)
 }
@@ -420,7 +880,7 @@ func (t *InternetSearchTool) getOrderedProviders(searchType, userRequestedProvid
 
 	// Add any remaining providers not in priority order (for future extensibility)
 	for providerName, provider := range t.providers {
-		if !slices.Contains(orderedProviders, providerName) && t.providerSupportsType(provider, searchType) {
+		if !slices.Contains(orderedProviders, providerName) && !slices.Contains(siteProviders, providerName) && t.providerSupportsType(provider, searchType) {
 			orderedProviders = append(orderedProviders, providerName)
 		}
 	}
@@ -482,13 +942,49 @@ func (t *InternetSearchTool) ProvideExtendedInfo() *tools.ExtendedHelp {
 				"query":      "programming tutorials",
 				"provider":   "searxng",
 				"language":   "en",
-				"safesearch": "1",
+				"safesearch": "moderate",
 				"pageno":     2,
 			},
 			ExpectedResult: "Returns programming tutorials in English with moderate safe search, page 2",
 		})
 	}
 
+	if t.hasProvider("hackernews") {
+		examples = append(examples, tools.ToolExample{
+			Description: "Hacker News discussion of a topic, newest first",
+			Arguments: map[string]any{
+				"query":      "sqlite in production",
+				"provider":   "hackernews",
+				"sort":       "date",
+				"time_range": "month",
+			},
+			ExpectedResult: "Returns Hacker News stories from the past month linking to their discussion pages, with points, comment_count, author and the linked article_url in metadata",
+		})
+	}
+
+	if t.hasProvider("arxiv") {
+		examples = append(examples, tools.ToolExample{
+			Description: "Recent arXiv papers by an author in a category",
+			Arguments: map[string]any{
+				"type":           "papers",
+				"query":          "reinforcement learning",
+				"arxiv_author":   "sutton",
+				"arxiv_category": "cs.LG",
+				"sort":           "date",
+			},
+			ExpectedResult: "Returns matching arXiv papers, newest first, with the abstract as the description and authors, categories, doi and pdf_url in metadata",
+		})
+	}
+
+	examples = append(examples, tools.ToolExample{
+		Description: "List providers and check they respond",
+		Arguments: map[string]any{
+			"action": "list_providers",
+			"check":  true,
+		},
+		ExpectedResult: "Returns every provider with whether it is available, its supported types, the environment variables it needs and, for available providers, the latency or failure of a one-result test search",
+	})
+
 	commonPatterns := []string{
 		"Use count parameter to control result volume (more results = more context but higher latency)",
 		"Combine with fetch_url tool to get full content from interesting search results",
@@ -536,9 +1032,18 @@ func (t *InternetSearchTool) ProvideExtendedInfo() *tools.ExtendedHelp {
 	if t.hasProvider("brave") {
 		apiRequirements = append(apiRequirements, "BRAVE_API_KEY for Brave")
 	}
+	if t.hasProvider("tavily") {
+		apiRequirements = append(apiRequirements, "TAVILY_API_KEY for Tavily")
+	}
+	if t.hasProvider("perplexity") {
+		apiRequirements = append(apiRequirements, "PERPLEXITY_API_KEY for Perplexity")
+	}
 	if t.hasProvider("searxng") {
 		apiRequirements = append(apiRequirements, "SEARXNG_BASE_URL for SearXNG")
 	}
+	if t.hasProvider("github") {
+		apiRequirements = append(apiRequirements, "GITHUB_TOKEN for GitHub code search")
+	}
 
 	if len(apiRequirements) > 0 {
 		troubleshooting = append(troubleshooting, tools.TroubleshootingTip{
@@ -577,9 +1082,24 @@ func (t *InternetSearchTool) ProvideExtendedInfo() *tools.ExtendedHelp {
 	}
 
 	parameterDetails := map[string]string{
-		"query": "The search query should be descriptive but not too long. Use natural language rather than keyword stuffing.",
-		"type":  "Internet search is default and most versatile. Use 'news' for current events, 'image' for visual content, 'video' for tutorials.",
-		"count": "More results provide broader coverage but increase latency. Typical range: 3-10 results for focused searches, 10-20 for research.",
+		"query":           "The search query should be descriptive but not too long. Use natural language rather than keyword stuffing.",
+		"type":            "Internet search is default and most versatile. Use 'news' for current events, 'image' for visual content, 'video' for tutorials.",
+		"count":           "More results provide broader coverage but increase latency. Typical range: 3-10 results for focused searches, 10-20 for research.",
+		"safesearch":      "Safe search filter: 'off', 'moderate' (default) or 'strict'. Maps to DuckDuckGo kp, Brave safesearch, Google safe (strict: active, off: off) and SearXNG safesearch (0/1/2). Kagi, Tavily and Perplexity have no safe search option.",
+		"time_range":      "Filter by time: 'day', 'week', 'month' or 'year'. Maps to DuckDuckGo df, Brave freshness (pd/pw/pm/py, an explicit freshness wins), Google dateRestrict, SearXNG and Tavily time_range and Perplexity's recency filter. The applied range is echoed in the response 'time_range' field; providers that cannot honour it report 'time_range_unsupported' in the response metadata.",
+		"region":          "DuckDuckGo region code such as 'us-en', 'uk-en', 'de-de' or 'au-en' (default: unset). DuckDuckGo uses it directly, Brave maps it to country, Google to gl/hl and SearXNG to language. The applied region is echoed in the response 'region' field.",
+		"providers":       "Search several providers concurrently, e.g. [\"brave\", \"duckduckgo\"], or set provider to \"all\". 'count' applies to each provider. Results are de-duplicated by URL; each keeps 'provider' and 'provider_rank' metadata, and duplicates found by other providers are listed in 'also_found_by'.",
+		"merge_strategy":  "'interleave' (default) alternates between providers by rank, 'grouped' keeps each provider's results together in priority order.",
+		"include_domains": "Only return results from these domains. A domain matches itself and its subdomains ('example.com' matches docs.example.com but not notexample.com). Tavily filters natively, Google uses siteSearch for a single domain, Brave, Google and DuckDuckGo add site: operators (up to 5), and results from every provider are checked afterwards. DuckDuckGo fetches extra pages to make up the count.",
+		"exclude_domains": "Drop results from these domains and their subdomains. Tavily filters natively, Brave and Google add -site: operators, and other providers' results are filtered after the search. The number of results removed is reported as 'domain_filtered' in the response metadata.",
+		"result_language": "Drop results that aren't confidently in this language, using each result's 'detected_language' metadata (an ISO 639-1 code and a 0-1 confidence detected offline from the title and description). Results need at least 0.5 confidence to be kept; results too short to judge are kept. The number removed is reported as 'language_filtered' in the response metadata. Unlike region, it filters what the provider returns rather than changing the search.",
+		"sort_by_date":    "Order results newest first by 'published_at', with undated results after them in rank order. Only applied when at least half of the results have a date; otherwise results stay in rank order and 'sort_by_date_skipped' is noted in the response metadata. Each result keeps its 'position' so the original rank is still visible. With several providers the merged results are sorted.",
+		"fetch_content":   "Fetch the top N result pages (up to 10) concurrently and attach the first ~2,000 characters of their readable text to each result's 'content' metadata. Pages disallowed by robots.txt, non-HTML content and failed fetches are marked with 'fetch_error' instead. All fetches share a deadline of SEARCH_FETCH_CONTENT_TIMEOUT (default: 10s), so only use it when snippets aren't enough to judge relevance.",
+		"timeout_seconds": "Seconds to wait for each provider before giving up, between 1 and 120 (default: INTERNET_SEARCH_TIMEOUT or 15). Lower it for interactive use or raise it for slow proxies. A timeout returns 'search timed out after Xs via provider Y' and falls back to the next provider as usual.",
+		"action":          "'search' (default) runs a search. 'list_providers' returns JSON describing every provider, including unconfigured ones with the environment variables they need, and needs no query.",
+		"check":           "With list_providers, run a one-result search against each available provider and report ok, latency_ms and any error. These searches count towards provider quotas.",
+		"debug":           "Use when a search unexpectedly returns no or odd results. Each raw provider response body (up to 2MB) is saved to ~/.mcp-devtools/debug and listed in the 'debug_responses' metadata with its redacted URL, status and headers. Debug searches bypass the cache. SEARCH_DEBUG=true enables it for every search, and files older than SEARCH_DEBUG_RETENTION (default: 24h) are removed.",
+		"no_cache":        "Identical searches are served from a cache for SEARCH_CACHE_TTL (default: 10m) and marked 'cached: true' with their original timestamp. Set to true to bypass the cache and refresh the entry.",
 	}
 
 	// Build provider description based on available providers
@@ -587,15 +1107,30 @@ func (t *InternetSearchTool) ProvideExtendedInfo() *tools.ExtendedHelp {
 	if t.hasProvider("brave") {
 		providerDescriptions = append(providerDescriptions, "Brave (requires API key) offers freshness filtering")
 	}
+	if t.hasProvider("tavily") {
+		providerDescriptions = append(providerDescriptions, "Tavily (requires API key) offers synthesised answers")
+	}
+	if t.hasProvider("perplexity") {
+		providerDescriptions = append(providerDescriptions, "Perplexity (requires API key) answers questions with cited sources via the 'answer' type")
+	}
 	if t.hasProvider("searxng") {
 		providerDescriptions = append(providerDescriptions, "SearXNG (requires instance URL) offers language options")
 	}
 	if t.hasProvider("duckduckgo") {
 		providerDescriptions = append(providerDescriptions, "DuckDuckGo (always available)")
 	}
+	if t.hasProvider("github") {
+		providerDescriptions = append(providerDescriptions, "GitHub (always available, GITHUB_TOKEN recommended) serves the 'repositories' and 'code' types")
+	}
+	if t.hasProvider("hackernews") {
+		providerDescriptions = append(providerDescriptions, "Hacker News (always available) searches HN stories and comments, and is only used when named")
+	}
+	if t.hasProvider("arxiv") {
+		providerDescriptions = append(providerDescriptions, "arXiv (always available) serves the 'papers' type, limited to one request every three seconds")
+	}
 
 	if len(providerDescriptions) > 0 {
-		parameterDetails["provider"] = strings.Join(providerDescriptions, ". ")
+		parameterDetails["provider"] = strings.Join(providerDescriptions, ". ") + ". A named provider is used without fallback; if it's unknown, unconfigured or doesn't support the type, the error lists the available providers and their types."
 	}
 
 	// Add provider-specific parameter details only for available providers
@@ -604,11 +1139,35 @@ func (t *InternetSearchTool) ProvideExtendedInfo() *tools.ExtendedHelp {
 		parameterDetails["offset"] = "Brave only: Skip first N results for pagination. Useful for getting more diverse results."
 	}
 
+	if t.hasProvider("tavily") {
+		parameterDetails["search_depth"] = "Tavily only: 'basic' (default, faster) or 'advanced' (more thorough, uses more credits)."
+		parameterDetails["include_answer"] = "Tavily only: When true (default) a synthesised answer is returned as the first result with type 'answer'."
+	}
+
 	if t.hasProvider("searxng") {
 		parameterDetails["language"] = "SearXNG only: Use language codes like 'en', 'fr', 'de', or 'all'. Affects both query processing and result filtering."
-		parameterDetails["safesearch"] = "SearXNG only: Safe search filter (0: None, 1: Moderate, 2: Strict). Default is moderate."
 		parameterDetails["pageno"] = "SearXNG only: Page number starting from 1. Use for pagination through results."
-		parameterDetails["time_range"] = "SearXNG only: Filter by time (day/month/year). Useful for recent content."
+	}
+
+	if t.hasProvider("github") {
+		parameterDetails["type"] += " Use 'repositories' or 'code' to search GitHub; code search needs GITHUB_TOKEN."
+	}
+
+	if t.hasProvider("arxiv") {
+		parameterDetails["type"] += " Use 'papers' to search arXiv."
+		parameterDetails["arxiv_title"] = "arXiv only: Narrow the search to titles (ti:). arxiv_author (au:), arxiv_abstract (abs:) and arxiv_category (cat:) work the same way, and all given fields must match. A query that already uses arXiv prefixes such as 'au:lecun OR au:hinton' is passed through unchanged."
+	}
+
+	if t.hasProvider("google") || t.hasProvider("arxiv") {
+		parameterDetails["start"] = "Google and arXiv only: Index of the first result, for pagination. Google's is 1-based (11 for the second page of 10, 0 is treated as 1) while arXiv's is zero-based (10 for the second page)."
+	}
+
+	if t.hasProvider("hackernews") {
+		parameterDetails["hn_item_type"] = "Hacker News only: 'story' (default), 'comment', 'ask_hn', 'show_hn' or 'all' for stories and comments."
+	}
+
+	if t.hasProvider("hackernews") || t.hasProvider("arxiv") {
+		parameterDetails["sort"] = "Hacker News and arXiv only: 'relevance' or 'date' (newest first, by submission date for arXiv). Defaults to relevance, except Hacker News news searches which default to date."
 	}
 
 	whenToUse := "Use internet search to find current information, research topics, discover resources, or gather multiple perspectives on a subject. Ideal for tasks requiring up-to-date information that may not be in training data."
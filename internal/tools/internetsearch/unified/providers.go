@@ -0,0 +1,144 @@
+package unified
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Values for the action argument
+	actionSearch        = "search"
+	actionListProviders = "list_providers"
+
+	// healthCheckQuery is searched for by check: true, generic enough that every provider returns results
+	healthCheckQuery = "test"
+)
+
+// providerListing is the list_providers response. Providers are in fallback priority order, then by name,
+// so the output is stable between calls.
+type providerListing struct {
+	DefaultProvider string         `json:"default_provider"`
+	Providers       []providerInfo `json:"providers"`
+}
+
+// providerInfo describes a single provider in the list_providers response
+type providerInfo struct {
+	Name            string          `json:"name"`
+	Available       bool            `json:"available"`
+	SupportedTypes  []string        `json:"supported_types"`
+	Fallback        bool            `json:"fallback"` // Used for automatic fallback and provider "all", rather than only when named
+	Summary         string          `json:"summary"`
+	RequiredEnvVars []string        `json:"required_env_vars,omitempty"`
+	OptionalEnvVars []string        `json:"optional_env_vars,omitempty"`
+	Health          *providerHealth `json:"health,omitempty"`
+}
+
+// providerHealth is the outcome of a check: true test search against an available provider
+type providerHealth struct {
+	OK          bool   `json:"ok"`
+	SearchType  string `json:"search_type"`
+	LatencyMS   int64  `json:"latency_ms"`
+	ResultCount int    `json:"result_count"`
+	Error       string `json:"error,omitempty"`
+}
+
+// listProviders describes every known provider, including those missing their configuration. With
+// check: true each available provider is also tested concurrently with a one-result search.
+func (t *InternetSearchTool) listProviders(ctx context.Context, logger *logrus.Logger, args map[string]any) (*mcp.CallToolResult, error) {
+	check, _ := args["check"].(bool)
+	timeout, err := t.parseSearchTimeout(args)
+	if err != nil {
+		return nil, err
+	}
+
+	listing := providerListing{DefaultProvider: t.defaultProvider()}
+	for _, provider := range t.providers {
+		listing.Providers = append(listing.Providers, describeProvider(provider, true))
+	}
+	for _, provider := range t.unconfigured {
+		listing.Providers = append(listing.Providers, describeProvider(provider, false))
+	}
+	slices.SortFunc(listing.Providers, func(a, b providerInfo) int {
+		return cmp.Or(cmp.Compare(priorityRank(a.Name), priorityRank(b.Name)), cmp.Compare(a.Name, b.Name))
+	})
+
+	if check {
+		var wg sync.WaitGroup
+		for i := range listing.Providers {
+			if !listing.Providers[i].Available {
+				continue
+			}
+			wg.Add(1)
+			go func(info *providerInfo) {
+				defer wg.Done()
+				info.Health = t.checkProvider(ctx, logger, info.Name, timeout)
+			}(&listing.Providers[i])
+		}
+		wg.Wait()
+	}
+
+	return internetsearch.NewToolResultJSON(listing)
+}
+
+// describeProvider combines a provider's self-description with its availability and search types
+func describeProvider(provider SearchProvider, available bool) providerInfo {
+	description := provider.Describe()
+	return providerInfo{
+		Name:            provider.GetName(),
+		Available:       available,
+		SupportedTypes:  provider.GetSupportedTypes(),
+		Fallback:        !slices.Contains(siteProviders, provider.GetName()),
+		Summary:         description.Summary,
+		RequiredEnvVars: description.RequiredEnvVars,
+		OptionalEnvVars: description.OptionalEnvVars,
+	}
+}
+
+// checkProvider runs a one-result search against a provider, preferring internet search when it is supported
+func (t *InternetSearchTool) checkProvider(ctx context.Context, logger *logrus.Logger, providerName string, timeout time.Duration) *providerHealth {
+	supportedTypes := t.providers[providerName].GetSupportedTypes()
+	searchType := "web"
+	if !slices.Contains(supportedTypes, searchType) && len(supportedTypes) > 0 {
+		searchType = supportedTypes[0]
+	}
+
+	started := time.Now()
+	outcome := t.searchWithTimeout(ctx, logger, providerName, searchType, map[string]any{
+		"query": healthCheckQuery,
+		"count": float64(1),
+	}, timeout)
+
+	health := &providerHealth{
+		SearchType: searchType,
+		LatencyMS:  time.Since(started).Milliseconds(),
+	}
+	if outcome.err != nil {
+		health.Error = withErrorHint(outcome.err).Error()
+	} else {
+		health.OK = true
+		health.ResultCount = len(outcome.response.Results)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"provider":   providerName,
+		"ok":         health.OK,
+		"latency_ms": health.LatencyMS,
+	}).Debug("Search provider health check completed")
+
+	return health
+}
+
+// priorityRank returns a provider's position in the fallback order, placing others after every priority provider
+func priorityRank(providerName string) int {
+	if rank := slices.Index(providerPriorityOrder, providerName); rank >= 0 {
+		return rank
+	}
+	return len(providerPriorityOrder)
+}
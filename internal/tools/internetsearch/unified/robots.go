@@ -0,0 +1,209 @@
+package unified
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+)
+
+const (
+	// robotsCacheTTL is how long a host's robots.txt rules are reused before being fetched again
+	robotsCacheTTL = time.Hour
+	// robotsMaxSize is the most of a robots.txt file that is parsed, per RFC 9309's 500 KiB minimum
+	robotsMaxSize = 512 * 1024
+)
+
+// robotsRule is a single Allow or Disallow line. Paths with wildcards or an end anchor are translated
+// to a regular expression once when parsed.
+type robotsRule struct {
+	allow   bool
+	path    string
+	matcher *regexp.Regexp // Nil for plain prefix paths
+}
+
+// robotsRules are the rules from a robots.txt group that apply to the content fetcher
+type robotsRules struct {
+	rules     []robotsRule
+	disallow  bool // Set when robots.txt couldn't be fetched, which RFC 9309 treats as a full disallow
+	fetchedAt time.Time
+}
+
+// robotsCache holds parsed robots.txt rules per scheme and host
+type robotsCache struct {
+	mu      sync.Mutex
+	entries map[string]*robotsRules
+}
+
+// parseRobots reads the rules from a robots.txt file for the given user agent token. A group naming the
+// agent takes precedence over the "*" group, even when it allows everything, as in RFC 9309.
+func parseRobots(body io.Reader, agent string) []robotsRule {
+	var agentRules, wildcardRules []robotsRule
+	var groupAgents []string
+	inRules, agentGroupSeen := false, false
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			// Consecutive user-agent lines share a group, one after a rule starts a new group
+			if inRules {
+				groupAgents = nil
+				inRules = false
+			}
+			groupAgents = append(groupAgents, value)
+			if robotsAgentMatches(value, agent) {
+				agentGroupSeen = true
+			}
+		case "allow", "disallow":
+			inRules = true
+			if value == "" {
+				continue // An empty disallow allows everything
+			}
+			rule := newRobotsRule(key == "allow", value)
+			for _, groupAgent := range groupAgents {
+				switch {
+				case groupAgent == "*":
+					wildcardRules = append(wildcardRules, rule)
+				case robotsAgentMatches(groupAgent, agent):
+					agentRules = append(agentRules, rule)
+				}
+			}
+		}
+	}
+
+	if agentGroupSeen {
+		return agentRules
+	}
+	return wildcardRules
+}
+
+// robotsAgentMatches reports whether a user-agent line names the agent's product token. RFC 9309 compares
+// the whole token case-insensitively, so "bot" doesn't match "mcp-devtools-bot".
+func robotsAgentMatches(groupAgent, agent string) bool {
+	return groupAgent != "*" && strings.EqualFold(groupAgent, agent)
+}
+
+// newRobotsRule builds a rule, translating a path with the "*" wildcard or a trailing "$" end anchor
+// to a regular expression
+func newRobotsRule(allow bool, path string) robotsRule {
+	rule := robotsRule{allow: allow, path: path}
+	if !strings.ContainsAny(path, "*$") {
+		return rule
+	}
+
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSuffix(path, "$")), `\*`, ".*")
+	if strings.HasSuffix(path, "$") {
+		expr += "$"
+	}
+	// QuoteMeta output always compiles
+	rule.matcher = regexp.MustCompile(expr)
+	return rule
+}
+
+// matches reports whether the rule's path matches a URL path
+func (r robotsRule) matches(path string) bool {
+	if r.matcher != nil {
+		return r.matcher.MatchString(path)
+	}
+	return strings.HasPrefix(path, r.path)
+}
+
+// allowed reports whether a path may be fetched. The longest matching rule wins, with Allow winning ties.
+func (r *robotsRules) allowed(path string) bool {
+	if r.disallow {
+		return false
+	}
+
+	allowed, matched := true, -1
+	for _, rule := range r.rules {
+		if !rule.matches(path) {
+			continue
+		}
+		if len(rule.path) > matched || (len(rule.path) == matched && rule.allow) {
+			allowed, matched = rule.allow, len(rule.path)
+		}
+	}
+	return allowed
+}
+
+// allowed fetches (or reuses) the robots.txt rules for a URL's host and checks the URL's path against them
+func (c *robotsCache) allowed(ctx context.Context, client internetsearch.HTTPClientInterface, target *url.URL) (bool, error) {
+	origin := target.Scheme + "://" + target.Host
+
+	c.mu.Lock()
+	rules, ok := c.entries[origin]
+	c.mu.Unlock()
+
+	if !ok || time.Since(rules.fetchedAt) > robotsCacheTTL {
+		var err error
+		if rules, err = fetchRobots(ctx, client, origin); err != nil {
+			return false, err
+		}
+		// An unreachable robots.txt is only honoured for this fetch so that a transient failure
+		// doesn't block the host until the entry expires
+		if !rules.disallow {
+			c.mu.Lock()
+			if c.entries == nil {
+				c.entries = make(map[string]*robotsRules)
+			}
+			c.entries[origin] = rules
+			c.mu.Unlock()
+		}
+	}
+
+	path := target.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if target.RawQuery != "" {
+		path += "?" + target.RawQuery
+	}
+	return rules.allowed(path), nil
+}
+
+// fetchRobots downloads and parses a host's robots.txt. A missing file allows everything, while a
+// server error disallows everything. Errors are only returned when the fetch itself was cancelled.
+func fetchRobots(ctx context.Context, client internetsearch.HTTPClientInterface, origin string) (*robotsRules, error) {
+	rules := &robotsRules{fetchedAt: time.Now()}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create robots.txt request: %w", err)
+	}
+	req.Header.Set("User-Agent", contentFetchUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		rules.disallow = true
+		return rules, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		rules.disallow = true
+	case resp.StatusCode == http.StatusOK:
+		rules.rules = parseRobots(io.LimitReader(resp.Body, robotsMaxSize), contentFetchAgentToken)
+	}
+	return rules, nil
+}
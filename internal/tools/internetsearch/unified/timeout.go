@@ -0,0 +1,103 @@
+package unified
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultSearchTimeout bounds how long each provider call may take by default
+	DefaultSearchTimeout = 15 * time.Second
+	// SearchTimeoutEnvVar is the environment variable for configuring the default timeout, in seconds
+	SearchTimeoutEnvVar = "INTERNET_SEARCH_TIMEOUT"
+
+	// minSearchTimeout is the shortest accepted timeout, internetsearch.MaxSearchTimeout the longest
+	minSearchTimeout = 1 * time.Second
+)
+
+// providerOutcome holds the result of a single provider call
+type providerOutcome struct {
+	provider string
+	response *internetsearch.SearchResponse
+	err      error
+}
+
+// getSearchTimeout returns the configured default provider timeout, accepting a number of seconds or a duration ("45s")
+func getSearchTimeout() time.Duration {
+	envValue := strings.TrimSpace(os.Getenv(SearchTimeoutEnvVar))
+	if envValue == "" {
+		return DefaultSearchTimeout
+	}
+
+	timeout, err := time.ParseDuration(envValue)
+	if seconds, atoiErr := strconv.Atoi(envValue); atoiErr == nil {
+		timeout, err = time.Duration(seconds)*time.Second, nil
+	}
+	if err != nil || timeout < minSearchTimeout || timeout > internetsearch.MaxSearchTimeout {
+		return DefaultSearchTimeout
+	}
+	return timeout
+}
+
+// parseSearchTimeout reads the optional timeout_seconds argument, falling back to the tool's
+// override (used by tests) and then the configured default
+func (t *InternetSearchTool) parseSearchTimeout(args map[string]any) (time.Duration, error) {
+	raw, ok := args["timeout_seconds"]
+	if !ok || raw == nil {
+		if t.searchTimeout > 0 {
+			return t.searchTimeout, nil
+		}
+		return getSearchTimeout(), nil
+	}
+
+	seconds, ok := raw.(float64)
+	timeout := time.Duration(seconds * float64(time.Second))
+	if !ok || timeout < minSearchTimeout || timeout > internetsearch.MaxSearchTimeout {
+		return 0, fmt.Errorf("invalid timeout_seconds %v, must be between %d and %d", raw, int(minSearchTimeout.Seconds()), int(internetsearch.MaxSearchTimeout.Seconds()))
+	}
+	return timeout, nil
+}
+
+// searchWithTimeout runs a single provider's search, abandoning it once the timeout expires. The timeout sits
+// under any client level timeout, and a provider that ignores cancellation can't hold up the search.
+func (t *InternetSearchTool) searchWithTimeout(ctx context.Context, logger *logrus.Logger, providerName, searchType string, args map[string]any, timeout time.Duration) providerOutcome {
+	providerCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Buffered so a provider that ignores cancellation can still finish without blocking
+	done := make(chan providerOutcome, 1)
+	go func() {
+		response, err := t.providers[providerName].Search(providerCtx, logger, searchType, args)
+		done <- providerOutcome{provider: providerName, response: response, err: err}
+	}()
+
+	timedOut := providerOutcome{
+		provider: providerName,
+		err:      &internetsearch.TimeoutError{Provider: providerName, Timeout: timeout},
+	}
+
+	select {
+	case outcome := <-done:
+		// A provider returning because its context expired timed out, whatever error it wrapped that in
+		if outcome.err != nil && ctx.Err() == nil && errors.Is(providerCtx.Err(), context.DeadlineExceeded) {
+			return timedOut
+		}
+		if outcome.err == nil && outcome.response == nil {
+			outcome.response = &internetsearch.SearchResponse{Provider: providerName}
+		}
+		return outcome
+	case <-providerCtx.Done():
+		if ctx.Err() != nil {
+			return providerOutcome{provider: providerName, err: ctx.Err()}
+		}
+		return timedOut
+	}
+}
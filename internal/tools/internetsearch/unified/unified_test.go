@@ -2,13 +2,23 @@ package unified
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
+	"time"
+	"unicode/utf8"
 
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/brave"
 	"github.com/sirupsen/logrus"
 )
 
@@ -58,6 +68,10 @@ func (m *mockProvider) GetSupportedTypes() []string {
 	return m.supportedTypes
 }
 
+func (m *mockProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{Summary: "Mock provider " + m.name}
+}
+
 // Test getOrderedProviders with no provider specified
 func TestGetOrderedProviders_DefaultOrder(t *testing.T) {
 	tool := &InternetSearchTool{
@@ -134,6 +148,52 @@ func TestGetOrderedProviders_SpecificProvider(t *testing.T) {
 	}
 }
 
+// Site providers are only used when named, never as fallbacks or in "all" searches
+func TestGetOrderedProviders_SiteProviders(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"duckduckgo": &resultsProvider{name: "duckduckgo", urls: []string{"https://go.dev/"}},
+		"hackernews": &resultsProvider{name: "hackernews", urls: []string{"https://news.ycombinator.com/item?id=1"}},
+	}}
+
+	if providers := tool.getOrderedProviders("web", ""); !slices.Equal(providers, []string{"duckduckgo"}) {
+		t.Errorf("Expected hackernews to be left out of the default order, got %v", providers)
+	}
+	if providers := tool.getOrderedProviders("web", "hackernews"); !slices.Equal(providers, []string{"hackernews"}) {
+		t.Errorf("Expected hackernews when requested by name, got %v", providers)
+	}
+
+	names, _, _ := tool.federatedProviders("web", map[string]any{"provider": allProviders})
+	if !slices.Equal(names, []string{"duckduckgo"}) {
+		t.Errorf("Expected \"all\" to leave out hackernews, got %v", names)
+	}
+	names, skipped, _ := tool.federatedProviders("web", map[string]any{"providers": []any{"hackernews", "duckduckgo"}})
+	if !slices.Equal(names, []string{"duckduckgo", "hackernews"}) || len(skipped) != 0 {
+		t.Errorf("Expected a providers list to include hackernews, got %v (skipped %v)", names, skipped)
+	}
+}
+
+// Provider-specific search types route to the providers advertising them
+func TestGetOrderedProviders_ProviderSpecificTypes(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &mockProvider{name: "brave", supportedTypes: []string{"web", "image", "news", "video"}},
+		"duckduckgo": &mockProvider{name: "duckduckgo", supportedTypes: []string{"web", "news", "video"}},
+		"github":     &mockProvider{name: "github", supportedTypes: []string{"repositories", "code"}},
+		"arxiv":      &mockProvider{name: "arxiv", supportedTypes: []string{"papers"}},
+	}}
+
+	for _, searchType := range []string{"repositories", "code"} {
+		if providers := tool.getOrderedProviders(searchType, ""); !slices.Equal(providers, []string{"github"}) {
+			t.Errorf("Expected %s searches to route to github, got %v", searchType, providers)
+		}
+	}
+	if providers := tool.getOrderedProviders("papers", ""); !slices.Equal(providers, []string{"arxiv"}) {
+		t.Errorf("Expected papers searches to route to arxiv, got %v", providers)
+	}
+	if providers := tool.getOrderedProviders("web", ""); slices.Contains(providers, "github") || slices.Contains(providers, "arxiv") {
+		t.Errorf("Expected github and arxiv to be left out of web searches, got %v", providers)
+	}
+}
+
 // Test Execute with successful first provider
 func TestExecute_SuccessFirstProvider(t *testing.T) {
 	braveProvider := &mockProvider{
@@ -413,3 +473,1040 @@ func TestExecute_NoProvidersSupport(t *testing.T) {
 		t.Errorf("Expected error to mention no providers support type, got: %s", err.Error())
 	}
 }
+
+// Test that a named provider is used even when it isn't first in the fallback order, and that providers
+// which can't serve the search return an error listing the alternatives instead of falling back
+func TestExecute_ForcedProvider(t *testing.T) {
+	tavilyProvider := &mockProvider{name: "tavily", supportedTypes: []string{"web", "news"}}
+	duckduckgoProvider := &mockProvider{name: "duckduckgo", supportedTypes: []string{"web", "image"}}
+	tool := &InternetSearchTool{
+		providers: map[string]SearchProvider{
+			"tavily":     tavilyProvider,
+			"duckduckgo": duckduckgoProvider,
+		},
+		unconfigured: []SearchProvider{&brave.BraveProvider{}},
+	}
+
+	for _, args := range []map[string]any{
+		{"query": "golang", "provider": "duckduckgo", "no_cache": true},
+		{"query": "golang", "providers": []any{"duckduckgo"}, "no_cache": true},
+	} {
+		response, err := executeJSON(t, tool, args)
+		if err != nil {
+			t.Fatalf("Expected success, got error: %v", err)
+		}
+		if response.Provider != "duckduckgo" {
+			t.Errorf("Expected the forced provider to be used, got %s", response.Provider)
+		}
+	}
+	if tavilyProvider.callCount != 0 || duckduckgoProvider.callCount != 2 {
+		t.Errorf("Expected only duckduckgo to be called, got tavily=%d duckduckgo=%d", tavilyProvider.callCount, duckduckgoProvider.callCount)
+	}
+
+	available := "Available providers: tavily (web, news), duckduckgo (web, image)"
+	tests := []struct {
+		name string
+		args map[string]any
+		want []string
+	}{
+		{
+			name: "unknown provider",
+			args: map[string]any{"query": "golang", "provider": "bing"},
+			want: []string{`unknown provider "bing"`, available},
+		},
+		{
+			name: "unconfigured provider",
+			args: map[string]any{"query": "golang", "provider": "brave"},
+			want: []string{`provider "brave" is not available`, "BRAVE_API_KEY", available},
+		},
+		{
+			name: "unsupported type",
+			args: map[string]any{"query": "golang", "provider": "tavily", "type": "image"},
+			want: []string{`provider "tavily" does not support image search, it supports: web, news`, available},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := executeJSON(t, tool, tt.args)
+			if err == nil {
+				t.Fatal("Expected an error, got success")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected error to contain %q, got: %s", want, err)
+				}
+			}
+		})
+	}
+	if tavilyProvider.callCount != 0 || duckduckgoProvider.callCount != 2 {
+		t.Errorf("Expected no fallback searches, got tavily=%d duckduckgo=%d", tavilyProvider.callCount, duckduckgoProvider.callCount)
+	}
+}
+
+// Test that news searches prefer API-based providers and fall back to DuckDuckGo
+func TestGetOrderedProviders_NewsPrefersAPIProviders(t *testing.T) {
+	tool := &InternetSearchTool{
+		providers: map[string]SearchProvider{
+			"duckduckgo": &mockProvider{
+				name:           "duckduckgo",
+				supportedTypes: []string{"web", "news"},
+			},
+			"brave": &mockProvider{
+				name:           "brave",
+				supportedTypes: []string{"web", "image", "news", "video"},
+			},
+			"kagi": &mockProvider{
+				name:           "kagi",
+				supportedTypes: []string{"web"},
+			},
+		},
+	}
+
+	providers := tool.getOrderedProviders("news", "")
+	if len(providers) != 2 {
+		t.Fatalf("Expected 2 providers for news search, got %v", providers)
+	}
+	if providers[0] != "brave" || providers[1] != "duckduckgo" {
+		t.Errorf("Expected brave then duckduckgo for news, got %v", providers)
+	}
+}
+
+// Test that an invalid region is rejected before any provider is called
+func TestExecute_InvalidRegion(t *testing.T) {
+	braveProvider := &mockProvider{
+		name:           "brave",
+		supportedTypes: []string{"web"},
+	}
+	tool := &InternetSearchTool{
+		providers: map[string]SearchProvider{"brave": braveProvider},
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	_, err := tool.Execute(context.Background(), logger, &sync.Map{}, map[string]any{
+		"query":  "golang",
+		"region": "atlantis",
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid region") {
+		t.Fatalf("Expected invalid region error, got %v", err)
+	}
+	if braveProvider.callCount != 0 {
+		t.Errorf("Expected provider not to be called, got %d calls", braveProvider.callCount)
+	}
+}
+
+func TestExecute_CachesResponses(t *testing.T) {
+	braveProvider := &mockProvider{name: "brave", supportedTypes: []string{"web"}}
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{"brave": braveProvider}}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cache := &sync.Map{}
+
+	first, err := tool.Execute(context.Background(), logger, cache, map[string]any{"query": "Golang  Generics", "count": float64(5)})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if strings.Contains(resultText(t, first), `"cached"`) {
+		t.Error("Expected first response not to be marked cached")
+	}
+
+	// The query is normalised, so case and whitespace differences hit the same entry
+	second, err := tool.Execute(context.Background(), logger, cache, map[string]any{"query": "golang generics", "count": float64(5)})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if braveProvider.callCount != 1 {
+		t.Errorf("Expected cached response to be served, provider was called %d times", braveProvider.callCount)
+	}
+	if !strings.Contains(resultText(t, second), `"cached": true`) {
+		t.Error("Expected cached response to be marked cached")
+	}
+
+	// Different parameters are a different entry
+	if _, err := tool.Execute(context.Background(), logger, cache, map[string]any{"query": "golang generics", "count": float64(10)}); err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if braveProvider.callCount != 2 {
+		t.Errorf("Expected a new search for different parameters, provider was called %d times", braveProvider.callCount)
+	}
+
+	// no_cache bypasses and refreshes the entry
+	refreshed, err := tool.Execute(context.Background(), logger, cache, map[string]any{"query": "golang generics", "count": float64(5), "no_cache": true})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if braveProvider.callCount != 3 {
+		t.Errorf("Expected no_cache to bypass the cache, provider was called %d times", braveProvider.callCount)
+	}
+	if strings.Contains(resultText(t, refreshed), `"cached"`) {
+		t.Error("Expected refreshed response not to be marked cached")
+	}
+}
+
+func TestExecute_CacheExpiry(t *testing.T) {
+	braveProvider := &mockProvider{name: "brave", supportedTypes: []string{"web"}}
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{"brave": braveProvider}}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cache := &sync.Map{}
+	args := map[string]any{"query": "golang"}
+
+	key := searchCacheKey("brave", "web", "golang", args)
+	cache.Store(key, SearchCacheEntry{
+		Response:  &internetsearch.SearchResponse{Provider: "brave"},
+		ExpiresAt: time.Now().Add(-time.Second),
+	})
+	staleKey := searchCacheKey("brave", "web", "unrelated", args)
+	cache.Store(staleKey, SearchCacheEntry{Response: &internetsearch.SearchResponse{}, ExpiresAt: time.Now().Add(-time.Second)})
+
+	if _, err := tool.Execute(context.Background(), logger, cache, args); err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if braveProvider.callCount != 1 {
+		t.Errorf("Expected expired entry to be ignored, provider was called %d times", braveProvider.callCount)
+	}
+	if _, ok := cache.Load(staleKey); ok {
+		t.Error("Expected expired entries to be swept")
+	}
+}
+
+func TestExecute_CacheDisabled(t *testing.T) {
+	t.Setenv(SearchCacheTTLEnvVar, "0")
+
+	braveProvider := &mockProvider{name: "brave", supportedTypes: []string{"web"}}
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{"brave": braveProvider}}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cache := &sync.Map{}
+
+	for range 2 {
+		if _, err := tool.Execute(context.Background(), logger, cache, map[string]any{"query": "golang"}); err != nil {
+			t.Fatalf("Expected success, got error: %v", err)
+		}
+	}
+	if braveProvider.callCount != 2 {
+		t.Errorf("Expected caching to be disabled, provider was called %d times", braveProvider.callCount)
+	}
+}
+
+func TestGetSearchCacheTTL(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":        DefaultSearchCacheTTL,
+		"300":     5 * time.Minute,
+		"15m":     15 * time.Minute,
+		"invalid": DefaultSearchCacheTTL,
+	}
+	for value, want := range tests {
+		t.Setenv(SearchCacheTTLEnvVar, value)
+		if got := getSearchCacheTTL(); got != want {
+			t.Errorf("SEARCH_CACHE_TTL=%q: expected %s, got %s", value, want, got)
+		}
+	}
+}
+
+// resultText returns the text content of a tool result
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	if result == nil || len(result.Content) == 0 {
+		t.Fatal("Expected result content")
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("Expected text content, got %T", result.Content[0])
+	}
+	return text.Text
+}
+
+func TestExecute_BlockedProviderFallsBack(t *testing.T) {
+	braveProvider := &mockProvider{
+		name:           "brave",
+		shouldFail:     true,
+		failureError:   fmt.Errorf("%w: bot challenge", internetsearch.ErrProviderBlocked),
+		supportedTypes: []string{"web"},
+	}
+	ddgProvider := &mockProvider{name: "duckduckgo", supportedTypes: []string{"web"}}
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{"brave": braveProvider, "duckduckgo": ddgProvider}}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	if _, err := tool.Execute(context.Background(), logger, &sync.Map{}, map[string]any{"query": "golang"}); err != nil {
+		t.Fatalf("Expected fallback to succeed, got error: %v", err)
+	}
+	if ddgProvider.callCount != 1 {
+		t.Errorf("Expected fallback provider to be called once, was called %d times", ddgProvider.callCount)
+	}
+
+	// An explicitly requested provider keeps the typed error
+	_, err := tool.Execute(context.Background(), logger, &sync.Map{}, map[string]any{"query": "golang", "provider": "brave"})
+	if !errors.Is(err, internetsearch.ErrProviderBlocked) {
+		t.Errorf("Expected ErrProviderBlocked to be preserved, got %v", err)
+	}
+}
+
+// resultsProvider returns fixed results after an optional delay, for federated search tests
+type resultsProvider struct {
+	name         string
+	urls         []string
+	descriptions []string // Optional, matched to urls by index
+	delay        time.Duration
+	err          error
+}
+
+func (p *resultsProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	if p.delay > 0 {
+		select {
+		case <-time.After(p.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	response := &internetsearch.SearchResponse{Provider: p.name, Timestamp: time.Now()}
+	for i, u := range p.urls {
+		result := internetsearch.SearchResult{
+			Title:    fmt.Sprintf("%s result %d", p.name, i+1),
+			URL:      u,
+			Metadata: map[string]any{"provider": p.name, "position": i + 1},
+		}
+		if i < len(p.descriptions) {
+			result.Description = p.descriptions[i]
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
+func (p *resultsProvider) GetName() string             { return p.name }
+func (p *resultsProvider) IsAvailable() bool           { return true }
+func (p *resultsProvider) GetSupportedTypes() []string { return []string{"web"} }
+func (p *resultsProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{Summary: "Fixed results from " + p.name}
+}
+
+// executeJSON runs the tool and decodes the JSON response
+func executeJSON(t *testing.T, tool *InternetSearchTool, args map[string]any) (*internetsearch.SearchResponse, error) {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	result, err := tool.Execute(context.Background(), logger, &sync.Map{}, args)
+	if err != nil {
+		return nil, err
+	}
+	var response internetsearch.SearchResponse
+	if err := json.Unmarshal([]byte(resultText(t, result)), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return &response, nil
+}
+
+func TestExecute_FederatedMerge(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &resultsProvider{name: "brave", urls: []string{"https://go.dev/doc/", "https://pkg.go.dev/fmt", "https://gobyexample.com/"}},
+		"duckduckgo": &resultsProvider{name: "duckduckgo", urls: []string{"http://www.go.dev/doc", "https://go.dev/blog/"}},
+	}}
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		wantURLs []string
+	}{
+		{
+			name:     "interleave by rank",
+			args:     map[string]any{"query": "golang", "providers": []any{"duckduckgo", "brave"}},
+			wantURLs: []string{"https://go.dev/doc/", "https://pkg.go.dev/fmt", "https://go.dev/blog/", "https://gobyexample.com/"},
+		},
+		{
+			name:     "grouped by provider",
+			args:     map[string]any{"query": "golang", "provider": "all", "merge_strategy": "grouped"},
+			wantURLs: []string{"https://go.dev/doc/", "https://pkg.go.dev/fmt", "https://gobyexample.com/", "https://go.dev/blog/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := executeJSON(t, tool, tt.args)
+			if err != nil {
+				t.Fatalf("Expected success, got error: %v", err)
+			}
+
+			var urls []string
+			for _, result := range response.Results {
+				urls = append(urls, result.URL)
+			}
+			if !slices.Equal(urls, tt.wantURLs) {
+				t.Errorf("Expected URLs %v, got %v", tt.wantURLs, urls)
+			}
+
+			// The brave result ranks first and is kept, the duckduckgo duplicate is recorded against it
+			first := response.Results[0]
+			if first.Metadata["provider"] != "brave" || first.Metadata["provider_rank"] != float64(1) {
+				t.Errorf("Expected first result from brave at rank 1, got %v", first.Metadata)
+			}
+			if alsoFoundBy, _ := first.Metadata["also_found_by"].([]any); len(alsoFoundBy) != 1 || alsoFoundBy[0] != "duckduckgo" {
+				t.Errorf("Expected duplicate to be recorded in also_found_by, got %v", first.Metadata["also_found_by"])
+			}
+			if response.DuplicatesRemoved != 1 {
+				t.Errorf("Expected 1 duplicate removed, got %d", response.DuplicatesRemoved)
+			}
+			if response.Provider != "brave,duckduckgo" {
+				t.Errorf("Expected combined provider name, got %q", response.Provider)
+			}
+		})
+	}
+}
+
+func TestExecute_FederatedPartialFailure(t *testing.T) {
+	tool := &InternetSearchTool{
+		providers: map[string]SearchProvider{
+			"brave":      &resultsProvider{name: "brave", err: fmt.Errorf("rate limit exceeded: brave")},
+			"google":     &resultsProvider{name: "google", urls: []string{"https://example.com/slow"}, delay: time.Minute},
+			"duckduckgo": &resultsProvider{name: "duckduckgo", urls: []string{"https://example.com/"}},
+		},
+		searchTimeout: 50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	response, err := executeJSON(t, tool, map[string]any{"query": "golang", "providers": []any{"brave", "google", "duckduckgo", "kagi"}})
+	if err != nil {
+		t.Fatalf("Expected partial success, got error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected slow provider to be abandoned, took %s", elapsed)
+	}
+
+	if len(response.Results) != 1 || response.Results[0].Metadata["provider"] != "duckduckgo" {
+		t.Fatalf("Expected only the duckduckgo result, got %v", response.Results)
+	}
+
+	providerErrors, _ := response.Metadata["provider_errors"].(map[string]any)
+	for _, name := range []string{"brave", "google", "kagi"} {
+		if _, ok := providerErrors[name]; !ok {
+			t.Errorf("Expected a provider_errors entry for %s, got %v", name, providerErrors)
+		}
+	}
+	if note, _ := providerErrors["google"].(string); !strings.Contains(note, "timed out") {
+		t.Errorf("Expected timeout note for google, got %q", note)
+	}
+}
+
+func TestExecute_FederatedErrors(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &resultsProvider{name: "brave", err: errors.New("invalid API key")},
+		"duckduckgo": &resultsProvider{name: "duckduckgo", err: internetsearch.ErrProviderBlocked},
+	}}
+
+	_, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": "all"})
+	if err == nil || !strings.Contains(err.Error(), "all providers failed") {
+		t.Errorf("Expected all providers failed error, got %v", err)
+	}
+
+	_, err = executeJSON(t, tool, map[string]any{"query": "golang", "provider": "all", "merge_strategy": "random"})
+	if err == nil || !strings.Contains(err.Error(), "merge_strategy") {
+		t.Errorf("Expected invalid merge_strategy error, got %v", err)
+	}
+
+	_, err = executeJSON(t, tool, map[string]any{"query": "golang", "providers": []any{"kagi", "tavily"}})
+	if err == nil || !strings.Contains(err.Error(), "no requested providers") {
+		t.Errorf("Expected no requested providers error, got %v", err)
+	}
+}
+
+func TestExecute_DomainFilters(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &resultsProvider{name: "brave", urls: []string{"https://go.dev/doc", "https://www.pinterest.com/pin/1", "https://pkg.go.dev/fmt"}},
+		"duckduckgo": &resultsProvider{name: "duckduckgo", urls: []string{"https://uk.pinterest.com/pin/2", "https://go.dev/blog"}},
+	}}
+
+	// Providers that ignore the filter still have their results filtered
+	response, err := executeJSON(t, tool, map[string]any{
+		"query":           "golang",
+		"provider":        "brave",
+		"include_domains": []any{"go.dev"},
+		"no_cache":        true,
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if len(response.Results) != 2 || response.Results[1].URL != "https://pkg.go.dev/fmt" {
+		t.Errorf("Expected go.dev results including subdomains, got %+v", response.Results)
+	}
+	if response.Metadata["domain_filtered"] != float64(1) {
+		t.Errorf("Expected domain_filtered=1, got %v", response.Metadata["domain_filtered"])
+	}
+
+	response, err = executeJSON(t, tool, map[string]any{
+		"query":           "golang",
+		"provider":        "all",
+		"exclude_domains": []any{"pinterest.com"},
+		"no_cache":        true,
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	for _, result := range response.Results {
+		if strings.Contains(result.URL, "pinterest.com") {
+			t.Errorf("Expected excluded domain to be removed from federated results, got %q", result.URL)
+		}
+	}
+	if len(response.Results) != 3 {
+		t.Errorf("Expected 3 federated results, got %d", len(response.Results))
+	}
+
+	if _, err := executeJSON(t, tool, map[string]any{"query": "golang", "include_domains": []any{"not a domain"}}); err == nil {
+		t.Error("Expected error for invalid domain")
+	}
+}
+
+func TestExecute_ResultLanguage(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave": &resultsProvider{
+			name: "brave",
+			urls: []string{"https://go.dev/doc", "https://golang.de/", "https://go.dev/blog"},
+			descriptions: []string{
+				"Documentation for the Go programming language, including tutorials and the language specification",
+				"Die deutschsprachige Seite über die Programmiersprache Go mit Anleitungen und Beispielen",
+				"",
+			},
+		},
+		"duckduckgo": &resultsProvider{
+			name:         "duckduckgo",
+			urls:         []string{"https://golang.fr/"},
+			descriptions: []string{"Le site francophone consacré au langage de programmation Go et à sa communauté"},
+		},
+	}}
+
+	for _, provider := range []string{"brave", "all"} {
+		response, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": provider, "result_language": "de", "no_cache": true})
+		if err != nil {
+			t.Fatalf("Expected success with provider %s, got error: %v", provider, err)
+		}
+		if len(response.Results) != 1 || response.Results[0].URL != "https://golang.de/" {
+			t.Errorf("Expected only the German result with provider %s, got %+v", provider, response.Results)
+		}
+		language, _ := response.Results[0].Metadata["detected_language"].(map[string]any)
+		if language["code"] != "de" {
+			t.Errorf("Expected German language metadata with provider %s, got %v", provider, response.Results[0].Metadata)
+		}
+	}
+
+	response, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": "brave", "result_language": "de", "no_cache": true})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if response.Metadata["language_filtered"] != float64(2) {
+		t.Errorf("Expected language_filtered=2, got %v", response.Metadata["language_filtered"])
+	}
+
+	if _, err := executeJSON(t, tool, map[string]any{"query": "golang", "result_language": "german"}); err == nil || !strings.Contains(err.Error(), "result_language") {
+		t.Errorf("Expected invalid result_language error, got %v", err)
+	}
+}
+
+func TestExecute_SortByDate(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave": &resultsProvider{
+			name:         "brave",
+			urls:         []string{"https://go.dev/doc", "https://go.dev/blog/go1.22", "https://go.dev/blog/go1.21"},
+			descriptions: []string{"Documentation", "Feb 6, 2024 · Go 1.22 is released", "Aug 8, 2023 · Go 1.21 is released"},
+		},
+		"duckduckgo": &resultsProvider{
+			name: "duckduckgo",
+			urls: []string{"https://pkg.go.dev/", "https://go.dev/play"},
+		},
+	}}
+
+	response, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": "brave", "sort_by_date": true, "no_cache": true})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	want := []string{"https://go.dev/blog/go1.22", "https://go.dev/blog/go1.21", "https://go.dev/doc"}
+	for i, result := range response.Results {
+		if result.URL != want[i] {
+			t.Errorf("Expected %s at %d, got %s", want[i], i, result.URL)
+		}
+	}
+	first := response.Results[0]
+	if first.Metadata["published_at"] != "2024-02-06T00:00:00Z" || first.Description != "Go 1.22 is released" {
+		t.Errorf("Expected the snippet date to be extracted, got %+v", first)
+	}
+
+	// Most of the merged results are undated, so they stay in rank order
+	response, err = executeJSON(t, tool, map[string]any{"query": "golang", "provider": "all", "sort_by_date": true, "no_cache": true})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if response.Results[0].URL != "https://go.dev/doc" || response.Metadata["sort_by_date_skipped"] == nil {
+		t.Errorf("Expected rank order with a note, got %+v and %v", response.Results, response.Metadata)
+	}
+}
+
+// capturingProvider records a raw response with debug capture, as the real providers' clients do
+type capturingProvider struct {
+	resultsProvider
+	calls int
+}
+
+func (p *capturingProvider) Search(ctx context.Context, logger *logrus.Logger, searchType string, args map[string]any) (*internetsearch.SearchResponse, error) {
+	p.calls++
+	internetsearch.CaptureResponse(ctx, logger, p.name, &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+	}, []byte(`{"results": []}`))
+	return p.resultsProvider.Search(ctx, logger, searchType, args)
+}
+
+func TestExecute_Debug(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(internetsearch.SearchDebugEnvVar, "")
+	provider := &capturingProvider{resultsProvider: resultsProvider{name: "brave", urls: []string{"https://go.dev/"}}}
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{"brave": provider}}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cache := &sync.Map{}
+	execute := func(args map[string]any) *internetsearch.SearchResponse {
+		t.Helper()
+		result, err := tool.Execute(context.Background(), logger, cache, args)
+		if err != nil {
+			t.Fatalf("Expected success, got error: %v", err)
+		}
+		var response internetsearch.SearchResponse
+		if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &response); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return &response
+	}
+
+	// Prime the cache so the debug search has to bypass it
+	execute(map[string]any{"query": "golang"})
+	if response := execute(map[string]any{"query": "golang"}); !response.Cached || response.Metadata["debug_responses"] != nil {
+		t.Fatalf("Expected a cached response without debug metadata, got %+v", response)
+	}
+
+	response := execute(map[string]any{"query": "golang", "debug": true})
+	if response.Cached || provider.calls != 2 {
+		t.Errorf("Expected the debug search to bypass the cache, got cached=%v after %d calls", response.Cached, provider.calls)
+	}
+	responses, _ := response.Metadata["debug_responses"].([]any)
+	if len(responses) != 1 {
+		t.Fatalf("Expected one captured response, got %v", response.Metadata["debug_responses"])
+	}
+	captured, _ := responses[0].(map[string]any)
+	bodyFile, _ := captured["body_file"].(string)
+	if captured["provider"] != "brave" || captured["status"] != float64(http.StatusOK) || bodyFile == "" {
+		t.Errorf("Unexpected captured response: %v", captured)
+	}
+	if body, err := os.ReadFile(bodyFile); err != nil || string(body) != `{"results": []}` {
+		t.Errorf("Expected the raw body to be saved, got %q (%v)", body, err)
+	}
+}
+
+func TestExecute_HostMetadata(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &resultsProvider{name: "brave", urls: []string{"https://docs.example.co.uk/guide"}},
+		"duckduckgo": &resultsProvider{name: "duckduckgo", urls: []string{"https://www.münchen.de/", "http://192.168.1.10/status"}},
+	}}
+
+	for _, provider := range []string{"brave", "all"} {
+		response, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": provider, "no_cache": true})
+		if err != nil {
+			t.Fatalf("Expected success with provider %s, got error: %v", provider, err)
+		}
+		first := response.Results[0].Metadata
+		if first["domain"] != "example.co.uk" || first["host"] != "docs.example.co.uk" || first["favicon_url"] != "https://docs.example.co.uk/favicon.ico" {
+			t.Errorf("Unexpected host metadata with provider %s: %v", provider, first)
+		}
+	}
+
+	response, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": "duckduckgo", "no_cache": true})
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	if idn := response.Results[0].Metadata; idn["domain"] != "münchen.de" || idn["favicon_url"] != "https://www.xn--mnchen-3ya.de/favicon.ico" {
+		t.Errorf("Unexpected IDN host metadata: %v", idn)
+	}
+	if ip := response.Results[1].Metadata; ip["domain"] != "192.168.1.10" || ip["host"] != "192.168.1.10" {
+		t.Errorf("Unexpected IP literal host metadata: %v", ip)
+	}
+}
+
+func TestRobotsRules(t *testing.T) {
+	robotsTxt := `# Example robots.txt
+User-agent: *
+Disallow: /private
+Allow: /private/public
+Disallow: /*.pdf$
+
+User-agent: OtherBot
+Disallow: /
+`
+	rules := &robotsRules{rules: parseRobots(strings.NewReader(robotsTxt), contentFetchAgentToken)}
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/", want: true},
+		{path: "/article", want: true},
+		{path: "/private", want: false},
+		{path: "/private/notes", want: false},
+		{path: "/private/public/page", want: true},
+		{path: "/docs/guide.pdf", want: false},
+		{path: "/docs/guide.pdf?download=1", want: true},
+	}
+	for _, tt := range tests {
+		if got := rules.allowed(tt.path); got != tt.want {
+			t.Errorf("allowed(%q) = %v, expected %v", tt.path, got, tt.want)
+		}
+	}
+
+	// A group naming the fetcher replaces the wildcard group
+	specific := &robotsRules{rules: parseRobots(strings.NewReader("User-agent: *\nDisallow: /\n\nUser-agent: mcp-devtools\nUser-agent: AnotherBot\nDisallow: /admin\n"), contentFetchAgentToken)}
+	if !specific.allowed("/article") || specific.allowed("/admin/users") {
+		t.Error("Expected the mcp-devtools group to take precedence over the wildcard group")
+	}
+
+	// An empty Disallow in the fetcher's own group allows everything, rather than falling back to "*"
+	allowAll := &robotsRules{rules: parseRobots(strings.NewReader("User-agent: *\nDisallow: /\n\nUser-agent: MCP-DevTools\nDisallow:\n"), contentFetchAgentToken)}
+	if !allowAll.allowed("/article") {
+		t.Error("Expected an empty Disallow for the fetcher's group to allow everything")
+	}
+
+	// Groups match the whole product token, so short or partial tokens fall back to "*"
+	for _, groupAgent := range []string{"b", "mcp", "mcp-devtools-extra"} {
+		partial := &robotsRules{rules: parseRobots(strings.NewReader("User-agent: *\nDisallow: /private\n\nUser-agent: "+groupAgent+"\nDisallow: /\n"), contentFetchAgentToken)}
+		if !partial.allowed("/article") || partial.allowed("/private") {
+			t.Errorf("Expected user-agent %q not to match the fetcher", groupAgent)
+		}
+	}
+
+	// Wildcard patterns are translated once when parsed
+	for _, rule := range rules.rules {
+		if (rule.matcher != nil) != strings.ContainsAny(rule.path, "*$") {
+			t.Errorf("Expected only wildcard rules to have a matcher, got %+v", rule)
+		}
+	}
+
+	if (&robotsRules{disallow: true}).allowed("/") {
+		t.Error("Expected an unreachable robots.txt to disallow everything")
+	}
+}
+
+func TestExecute_FetchContent(t *testing.T) {
+	t.Setenv(FetchContentTimeoutEnvVar, "1s")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+		case "/article":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(`<html><head><script>var tracking = true;</script></head><body>
+<nav>Home | About</nav><main><h1>Go  Modules</h1><p>Modules are how Go manages dependencies.</p></main><footer>Copyright</footer></body></html>`))
+		case "/long":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html><body><p>" + strings.Repeat("word ", 1000) + "</p></body></html>"))
+		case "/report.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			_, _ = w.Write([]byte("%PDF-1.7"))
+		case "/slow":
+			select {
+			case <-time.After(5 * time.Second):
+			case <-r.Context().Done():
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	urls := []string{"/article", "/private/page", "/report.pdf", "/missing", "/slow", "/long", "/not-fetched"}
+	for i, path := range urls {
+		urls[i] = server.URL + path
+	}
+	tool := &InternetSearchTool{
+		providers:      map[string]SearchProvider{"duckduckgo": &resultsProvider{name: "duckduckgo", urls: urls}},
+		contentFetcher: &contentFetcher{client: server.Client()},
+	}
+
+	start := time.Now()
+	response, err := executeJSON(t, tool, map[string]any{"query": "go modules", "fetch_content": float64(6)})
+	if err != nil {
+		t.Fatalf("Expected success despite failed fetches, got error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("Expected the content deadline to bound the search, took %s", elapsed)
+	}
+
+	metadata := func(i int) map[string]any { return response.Results[i].Metadata }
+	if got := metadata(0)["content"]; got != "Go Modules Modules are how Go manages dependencies." {
+		t.Errorf("Expected readable text without scripts or navigation, got %q", got)
+	}
+	expectedErrors := map[int]string{
+		1: "disallowed by robots.txt",
+		2: "skipped non-HTML content (application/pdf)",
+		3: "HTTP error 404",
+		4: "content fetch deadline of 1s exceeded",
+	}
+	for i, want := range expectedErrors {
+		if got := metadata(i)["fetch_error"]; got != want {
+			t.Errorf("Result %d: expected fetch_error %q, got %v", i, want, got)
+		}
+		if _, ok := metadata(i)["content"]; ok {
+			t.Errorf("Result %d: expected no content for a failed fetch", i)
+		}
+	}
+	if content, _ := metadata(5)["content"].(string); utf8.RuneCountInString(content) > contentExtractLength+1 || !strings.HasSuffix(content, "…") {
+		t.Errorf("Expected long content to be truncated to %d characters, got %d", contentExtractLength, utf8.RuneCountInString(content))
+	}
+	if _, ok := metadata(6)["content"]; ok {
+		t.Error("Expected only the top fetch_content results to be fetched")
+	}
+	if response.Metadata["content_fetched"] != float64(2) {
+		t.Errorf("Expected content_fetched=2, got %v", response.Metadata["content_fetched"])
+	}
+
+	for _, invalid := range []any{float64(-1), float64(11), float64(2.5), "3"} {
+		if _, err := executeJSON(t, tool, map[string]any{"query": "go modules", "fetch_content": invalid}); err == nil {
+			t.Errorf("Expected error for fetch_content %v", invalid)
+		}
+	}
+}
+
+func TestExecute_ClassifiedProviderErrors(t *testing.T) {
+	authErr := fmt.Errorf("%w: invalid API key", internetsearch.ErrAuth)
+	quotaErr := fmt.Errorf("google API %w: the daily query limit has been reached", internetsearch.ErrQuotaExceeded)
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &mockProvider{name: "brave", shouldFail: true, failureError: authErr, supportedTypes: []string{"web"}},
+		"google":     &mockProvider{name: "google", shouldFail: true, failureError: quotaErr, supportedTypes: []string{"web"}},
+		"duckduckgo": &mockProvider{name: "duckduckgo", shouldFail: true, failureError: &internetsearch.RateLimitError{Provider: "duckduckgo", RetryAfter: 30 * time.Second}, supportedTypes: []string{"web"}},
+	}}
+
+	// An explicitly requested provider gets guidance for its error while keeping the cause
+	_, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": "brave"})
+	if !errors.Is(err, internetsearch.ErrAuth) || !errors.Is(err, authErr) {
+		t.Errorf("Expected ErrAuth to be preserved, got %v", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "API key is set correctly") {
+		t.Errorf("Expected an API key hint, got %v", err)
+	}
+
+	// Auth and quota failures fall back without the usual delay, and every failure stays matchable
+	start := time.Now()
+	_, err = executeJSON(t, tool, map[string]any{"query": "golang"})
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected no fallback delay after auth and quota failures, took %s", elapsed)
+	}
+	if err == nil || !strings.Contains(err.Error(), "all providers failed") {
+		t.Fatalf("Expected all providers to fail, got %v", err)
+	}
+	for _, want := range []error{internetsearch.ErrAuth, internetsearch.ErrQuotaExceeded, internetsearch.ErrRateLimited} {
+		if !errors.Is(err, want) {
+			t.Errorf("Expected combined error to match %v, got %v", want, err)
+		}
+	}
+	var rateLimitErr *internetsearch.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf("Expected the rate limit's RetryAfter to be recoverable, got %v", rateLimitErr)
+	}
+	if !strings.Contains(err.Error(), "retry after 30s or use another provider") {
+		t.Errorf("Expected a rate limit hint, got %v", err)
+	}
+}
+
+func TestFallbackDelay(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{name: "unclassified", err: errors.New("boom"), want: 2 * fallbackBaseDelay},
+		{name: "rate limited", err: &internetsearch.RateLimitError{Provider: "brave"}, want: 2 * fallbackBaseDelay},
+		{name: "blocked", err: fmt.Errorf("%w: challenge", internetsearch.ErrProviderBlocked), want: 2 * fallbackBaseDelay},
+		{name: "network", err: fmt.Errorf("%w: timeout", internetsearch.ErrNetwork), want: 2 * fallbackBaseDelay},
+		{name: "auth", err: fmt.Errorf("%w: invalid API key", internetsearch.ErrAuth), want: 0},
+		{name: "quota", err: fmt.Errorf("%w: limit reached", internetsearch.ErrQuotaExceeded), want: 0},
+		{name: "parse", err: fmt.Errorf("%w: bad JSON", internetsearch.ErrParse), want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := fallbackDelay(2, tt.err); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestExecute_SearchTimeout(t *testing.T) {
+	tool := &InternetSearchTool{providers: map[string]SearchProvider{
+		"brave":      &resultsProvider{name: "brave", urls: []string{"https://example.com/slow"}, delay: time.Minute},
+		"duckduckgo": &resultsProvider{name: "duckduckgo", urls: []string{"https://example.com/"}},
+	}}
+
+	start := time.Now()
+	_, err := executeJSON(t, tool, map[string]any{"query": "golang", "provider": "brave", "timeout_seconds": float64(1)})
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("Expected the timeout to bound the search, took %s", elapsed)
+	}
+	var timeoutErr *internetsearch.TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Provider != "brave" || timeoutErr.Timeout != time.Second {
+		t.Fatalf("Expected a TimeoutError for brave, got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "search timed out after 1s via provider brave") {
+		t.Errorf("Expected a clear timeout message, got %q", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || errors.Is(err, internetsearch.ErrNetwork) {
+		t.Errorf("Expected a timeout to be distinct from network errors, got %v", err)
+	}
+
+	// Without an explicit provider a timeout falls back like any other failure
+	tool.searchTimeout = 50 * time.Millisecond
+	response, err := executeJSON(t, tool, map[string]any{"query": "golang", "no_cache": true})
+	if err != nil {
+		t.Fatalf("Expected fallback after the timeout, got error: %v", err)
+	}
+	if response.Provider != "duckduckgo" {
+		t.Errorf("Expected duckduckgo to answer, got %q", response.Provider)
+	}
+	if errs, _ := response.Results[0].Metadata["original_provider_errors"].([]any); len(errs) != 1 || !strings.Contains(errs[0].(string), "timed out after 50ms") {
+		t.Errorf("Expected the timeout to be recorded, got %v", response.Results[0].Metadata["original_provider_errors"])
+	}
+
+	for _, invalid := range []any{float64(0), float64(121), "5"} {
+		if _, err := executeJSON(t, tool, map[string]any{"query": "golang", "timeout_seconds": invalid}); err == nil || !strings.Contains(err.Error(), "timeout_seconds") {
+			t.Errorf("Expected error for timeout_seconds %v, got %v", invalid, err)
+		}
+	}
+}
+
+func TestGetSearchTimeout(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":     DefaultSearchTimeout,
+		"45":   45 * time.Second,
+		"90s":  90 * time.Second,
+		"0":    DefaultSearchTimeout,
+		"500":  DefaultSearchTimeout,
+		"soon": DefaultSearchTimeout,
+	}
+	for value, want := range tests {
+		t.Setenv(SearchTimeoutEnvVar, value)
+		if got := getSearchTimeout(); got != want {
+			t.Errorf("%s=%q: expected %s, got %s", SearchTimeoutEnvVar, value, want, got)
+		}
+	}
+}
+
+// unconfiguredProvider stands in for a provider whose API key isn't set
+type unconfiguredProvider struct{ mockProvider }
+
+func (p *unconfiguredProvider) IsAvailable() bool { return false }
+func (p *unconfiguredProvider) Describe() internetsearch.ProviderDescription {
+	return internetsearch.ProviderDescription{Summary: "Needs a key", RequiredEnvVars: []string{"BRAVE_API_KEY"}}
+}
+
+// listProvidersJSON runs the list_providers action and decodes the listing
+func listProvidersJSON(t *testing.T, tool *InternetSearchTool, args map[string]any) providerListing {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	result, err := tool.Execute(context.Background(), logger, &sync.Map{}, args)
+	if err != nil {
+		t.Fatalf("Expected success, got error: %v", err)
+	}
+	var listing providerListing
+	if err := json.Unmarshal([]byte(resultText(t, result)), &listing); err != nil {
+		t.Fatalf("Failed to decode listing: %v", err)
+	}
+	return listing
+}
+
+func TestExecute_ListProviders(t *testing.T) {
+	tool := &InternetSearchTool{
+		providers: map[string]SearchProvider{
+			"hackernews": &mockProvider{name: "hackernews", supportedTypes: []string{"web", "news"}},
+			"duckduckgo": &mockProvider{name: "duckduckgo", supportedTypes: []string{"web", "news", "video"}},
+			"github":     &mockProvider{name: "github", supportedTypes: []string{"repositories", "code"}},
+		},
+		unconfigured: []SearchProvider{
+			&unconfiguredProvider{mockProvider{name: "brave", supportedTypes: []string{"web", "image"}}},
+		},
+	}
+
+	// No query is needed to list providers, and no provider is searched without check
+	listing := listProvidersJSON(t, tool, map[string]any{"action": "list_providers"})
+	if listing.DefaultProvider != "duckduckgo" {
+		t.Errorf("Expected duckduckgo as the default provider, got %q", listing.DefaultProvider)
+	}
+
+	var names []string
+	for _, info := range listing.Providers {
+		names = append(names, info.Name)
+		if info.Health != nil {
+			t.Errorf("Expected no health check without check: true, got %+v for %s", info.Health, info.Name)
+		}
+	}
+	if !slices.Equal(names, []string{"brave", "duckduckgo", "github", "hackernews"}) {
+		t.Errorf("Expected providers in priority order then by name, got %v", names)
+	}
+
+	brave := listing.Providers[0]
+	if brave.Available || !slices.Equal(brave.RequiredEnvVars, []string{"BRAVE_API_KEY"}) {
+		t.Errorf("Expected brave to be unavailable and name its API key, got %+v", brave)
+	}
+	if !listing.Providers[1].Available || !listing.Providers[1].Fallback {
+		t.Errorf("Expected duckduckgo to be available for fallback, got %+v", listing.Providers[1])
+	}
+	if listing.Providers[3].Fallback {
+		t.Errorf("Expected hackernews to be excluded from fallback, got %+v", listing.Providers[3])
+	}
+
+	for _, provider := range tool.providers {
+		if calls := provider.(*mockProvider).callCount; calls != 0 {
+			t.Errorf("Expected no searches without check, got %d for %s", calls, provider.GetName())
+		}
+	}
+
+	if _, err := tool.Execute(context.Background(), logrus.New(), &sync.Map{}, map[string]any{"action": "delete"}); err == nil || !strings.Contains(err.Error(), "list_providers") {
+		t.Errorf("Expected an invalid action error listing the actions, got %v", err)
+	}
+}
+
+func TestExecute_ListProvidersCheck(t *testing.T) {
+	tool := &InternetSearchTool{
+		providers: map[string]SearchProvider{
+			"duckduckgo": &mockProvider{name: "duckduckgo", supportedTypes: []string{"web"}},
+			"github":     &mockProvider{name: "github", supportedTypes: []string{"repositories", "code"}},
+			"brave":      &mockProvider{name: "brave", supportedTypes: []string{"web"}, shouldFail: true, failureError: &internetsearch.RateLimitError{Provider: "brave"}},
+		},
+		unconfigured: []SearchProvider{
+			&unconfiguredProvider{mockProvider{name: "kagi", supportedTypes: []string{"web"}}},
+		},
+	}
+
+	listing := listProvidersJSON(t, tool, map[string]any{"action": "list_providers", "check": true})
+	health := make(map[string]*providerHealth)
+	for _, info := range listing.Providers {
+		health[info.Name] = info.Health
+	}
+
+	if h := health["duckduckgo"]; h == nil || !h.OK || h.SearchType != "web" || h.ResultCount != 1 {
+		t.Errorf("Expected a healthy duckduckgo check, got %+v", h)
+	}
+	if h := health["github"]; h == nil || !h.OK || h.SearchType != "repositories" {
+		t.Errorf("Expected github to be checked with its first supported type, got %+v", h)
+	}
+	if h := health["brave"]; h == nil || h.OK || !strings.Contains(h.Error, "rate limited") {
+		t.Errorf("Expected the brave failure to be reported, got %+v", h)
+	}
+	if h := health["kagi"]; h != nil {
+		t.Errorf("Expected unavailable providers not to be checked, got %+v", h)
+	}
+}
@@ -1,12 +1,12 @@
 package internetsearch
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +21,10 @@ const (
 	DefaultInternetSearchRateLimit = 1
 	// InternetSearchRateLimitEnvVar is the environment variable for configuring rate limit
 	InternetSearchRateLimitEnvVar = "INTERNET_SEARCH_RATE_LIMIT"
+
+	// MaxSearchTimeout is the longest a search may be allowed to take. The rate limited client uses it as
+	// its own timeout so that the caller's per-search timeout, not the client, decides when to give up.
+	MaxSearchTimeout = 120 * time.Second
 )
 
 // NewToolResultJSON creates a new tool result with JSON content
@@ -33,6 +37,30 @@ func NewToolResultJSON(data any) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(string(jsonBytes)), nil
 }
 
+// StringSliceArg extracts a list of non-empty strings from an argument that may be a []any, []string or a single string
+func StringSliceArg(args map[string]any, key string) []string {
+	var values []string
+	switch raw := args[key].(type) {
+	case []any:
+		for _, item := range raw {
+			if str, ok := item.(string); ok && strings.TrimSpace(str) != "" {
+				values = append(values, strings.TrimSpace(str))
+			}
+		}
+	case []string:
+		for _, str := range raw {
+			if strings.TrimSpace(str) != "" {
+				values = append(values, strings.TrimSpace(str))
+			}
+		}
+	case string:
+		if strings.TrimSpace(raw) != "" {
+			values = append(values, strings.TrimSpace(raw))
+		}
+	}
+	return values
+}
+
 // HTTPClientInterface defines the interface for HTTP clients
 type HTTPClientInterface interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -57,14 +85,18 @@ func getInternetSearchRateLimit() float64 {
 
 // NewRateLimitedHTTPClient creates a new rate-limited HTTP client for internet search with proxy support
 func NewRateLimitedHTTPClient() *RateLimitedHTTPClient {
-	rateLimit := getInternetSearchRateLimit()
+	return NewRateLimitedHTTPClientWithRate(getInternetSearchRateLimit())
+}
 
+// NewRateLimitedHTTPClientWithRate creates a rate-limited HTTP client with a fixed rate, for providers
+// whose API terms set a stricter limit than INTERNET_SEARCH_RATE_LIMIT
+func NewRateLimitedHTTPClientWithRate(requestsPerSecond float64) *RateLimitedHTTPClient {
 	// Use shared HTTP client factory with proxy support
-	client := httpclient.NewHTTPClientWithProxy(30 * time.Second)
+	client := httpclient.NewHTTPClientWithProxy(MaxSearchTimeout)
 
 	return &RateLimitedHTTPClient{
 		client:  client,
-		limiter: rate.NewLimiter(rate.Limit(rateLimit), 1), // Allow burst of 1
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), 1), // Allow burst of 1
 	}
 }
 
@@ -74,7 +106,7 @@ func (c *RateLimitedHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	defer c.mu.Unlock()
 
 	// Wait for rate limiter to allow the request
-	err := c.limiter.Wait(context.Background())
+	err := c.limiter.Wait(req.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -3,8 +3,13 @@ package testutils
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
+	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/sirupsen/logrus"
@@ -62,6 +67,7 @@ func (m *MockTool) Execute(ctx context.Context, logger *logrus.Logger, cache *sy
 type MockHTTPClient struct {
 	responses map[string]*MockHTTPResponse
 	err       error
+	requests  []*http.Request
 }
 
 // MockHTTPResponse simulates an HTTP response
@@ -115,6 +121,42 @@ func (m *MockHTTPClient) Get(url string) (*http.Response, error) {
 	}, nil
 }
 
+// Do simulates an HTTP request of any method, matching on the URL without its query string
+// (implements internetsearch.HTTPClientInterface)
+func (m *MockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	m.requests = append(m.requests, req)
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	requestURL := *req.URL
+	requestURL.RawQuery = ""
+	if response, ok := m.responses[requestURL.String()]; ok {
+		header := make(http.Header)
+		for key, value := range response.Headers {
+			header.Set(key, value)
+		}
+		return &http.Response{
+			StatusCode: response.StatusCode,
+			Body:       io.NopCloser(strings.NewReader(response.Body)),
+			Header:     header,
+			Request:    req,
+		}, nil
+	}
+
+	return &http.Response{
+		StatusCode: 404,
+		Body:       io.NopCloser(strings.NewReader("Not Found")),
+		Header:     make(http.Header),
+		Request:    req,
+	}, nil
+}
+
+// Requests returns the requests made through Do, in order
+func (m *MockHTTPClient) Requests() []*http.Request {
+	return m.requests
+}
+
 // MockReadCloser implements io.ReadCloser for mock responses
 type MockReadCloser struct {
 	content string
@@ -181,3 +223,36 @@ func (m *MockCache) Range(f func(key, value any) bool) {
 		}
 	}
 }
+
+// DiscardLogger returns a logger that drops all output, for tests that exercise failures providers log as errors
+func DiscardLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+// ReadFixture reads a recorded response from the testdata directory of the package under test
+func ReadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("Failed to read fixture %s: %v", name, err)
+	}
+	return body
+}
+
+// FixtureHandler serves a recorded fixture from testdata with the given content type and status,
+// recording the request it received when received isn't nil
+func FixtureHandler(t *testing.T, fixture, contentType string, status int, received **http.Request) http.HandlerFunc {
+	t.Helper()
+	body := ReadFixture(t, fixture)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if received != nil {
+			*received = r
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}
+}
@@ -0,0 +1,70 @@
+package tools_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch"
+	"github.com/sammcj/mcp-devtools/internal/tools/internetsearch/duckduckgo"
+	"github.com/sammcj/mcp-devtools/tests/testutils"
+)
+
+const duckDuckGoHTMLEndpoint = "https://html.duckduckgo.com/html"
+
+func newRecordedDuckDuckGoProvider(t *testing.T) (*duckduckgo.DuckDuckGoProvider, *testutils.MockHTTPClient) {
+	t.Helper()
+	page, err := os.ReadFile(filepath.Join("..", "fixtures", "duckduckgo", "web_results.html"))
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	client := testutils.NewMockHTTPClient().WithResponse(duckDuckGoHTMLEndpoint, 200, string(page))
+	return duckduckgo.NewDuckDuckGoProviderWithClient(client), client
+}
+
+func TestDuckDuckGoProvider_RecordedWebResults(t *testing.T) {
+	provider, client := newRecordedDuckDuckGoProvider(t)
+
+	response, err := provider.Search(testutils.CreateTestContext(), testutils.CreateTestLogger(), "web", map[string]any{
+		"query": "golang generics",
+		"count": float64(4),
+	})
+	testutils.AssertNoError(t, err)
+
+	requests := client.Requests()
+	testutils.AssertEqual(t, 1, len(requests))
+	testutils.AssertEqual(t, "POST", requests[0].Method)
+
+	// The sponsored result is skipped and every redirect is unwrapped to an absolute URL
+	want := []struct{ title, url string }{
+		{"Tutorial: Getting started with generics - The Go Programming Language", "https://go.dev/doc/tutorial/generics"},
+		{"An Introduction To Generics - The Go Programming Language", "https://go.dev/blog/intro-generics"},
+		{"Go by Example: Generics", "https://gobyexample.com/generics"},
+		{"constraints package - golang.org/x/exp/constraints - Go Packages", "https://pkg.go.dev/golang.org/x/exp/constraints"},
+	}
+	testutils.AssertEqual(t, len(want), len(response.Results))
+	for i, result := range response.Results {
+		testutils.AssertEqual(t, want[i].title, result.Title)
+		testutils.AssertEqual(t, want[i].url, result.URL)
+		testutils.AssertEqual(t, i+1, result.Metadata["position"])
+	}
+
+	testutils.AssertEqual(t, "The Go 1.18 release adds support for generics. Generics are the biggest change we've made to Go since the first open source release.", response.Results[1].Description)
+	testutils.AssertEqual(t, "duckduckgo", response.Provider)
+}
+
+func TestDuckDuckGoProvider_RecordedClientError(t *testing.T) {
+	errOffline := errors.New("network is unreachable")
+	client := testutils.NewMockHTTPClient().WithError(errOffline)
+
+	// A single attempt, so the test doesn't wait on retry backoff
+	t.Setenv("INTERNET_SEARCH_RETRY_ATTEMPTS", "1")
+	provider := duckduckgo.NewDuckDuckGoProviderWithClient(client)
+
+	_, err := provider.Search(testutils.CreateTestContext(), testutils.CreateTestLogger(), "web", map[string]any{"query": "golang"})
+	if !errors.Is(err, internetsearch.ErrNetwork) || !errors.Is(err, errOffline) {
+		t.Errorf("Expected a network error wrapping the client's error, got %v", err)
+	}
+	testutils.AssertEqual(t, 1, len(client.Requests()))
+}